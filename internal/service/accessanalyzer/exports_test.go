@@ -8,7 +8,9 @@ var (
 	ArchiveRuleParseResourceID  = archiveRuleParseResourceID
 	FindAnalyzerByName          = findAnalyzerByName
 	FindArchiveRuleByTwoPartKey = findArchiveRuleByTwoPartKey
+	FindGeneratedPolicyByJobID  = findGeneratedPolicyByJobID
 
-	ResourceAnalyzer    = resourceAnalyzer
-	ResourceArchiveRule = resourceArchiveRule
+	ResourceAnalyzer        = resourceAnalyzer
+	ResourceArchiveRule     = resourceArchiveRule
+	ResourceGeneratedPolicy = resourceGeneratedPolicy
 )
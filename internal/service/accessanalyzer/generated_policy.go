@@ -0,0 +1,425 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_accessanalyzer_generated_policy")
+func resourceGeneratedPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGeneratedPolicyCreate,
+		ReadWithoutTimeout:   resourceGeneratedPolicyRead,
+		UpdateWithoutTimeout: resourceGeneratedPolicyUpdate,
+		DeleteWithoutTimeout: resourceGeneratedPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cloudtrail_details": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_role": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+							// The access role is not returned by the API, so it is unknown after import.
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return old == "" && d.Id() != ""
+							},
+						},
+						"end_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidUTCTimestamp,
+						},
+						"start_time": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidUTCTimestamp,
+						},
+						"trail": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"all_regions": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"cloudtrail_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"regions": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidRegionName,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"completed_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expired": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"include_resource_placeholders": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"include_service_level_template": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"is_complete": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"started_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGeneratedPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	principalARN := d.Get("principal_arn").(string)
+	input := &accessanalyzer.StartPolicyGenerationInput{
+		ClientToken: aws.String(id.UniqueId()),
+		PolicyGenerationDetails: &types.PolicyGenerationDetails{
+			PrincipalArn: aws.String(principalARN),
+		},
+	}
+
+	if v, ok := d.GetOk("cloudtrail_details"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CloudTrailDetails = expandCloudTrailDetails(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.StartPolicyGeneration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting IAM Access Analyzer policy generation (%s): %s", principalARN, err)
+	}
+
+	d.SetId(aws.ToString(output.JobId))
+
+	if _, err := waitPolicyGenerationSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IAM Access Analyzer Generated Policy (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceGeneratedPolicyRead(ctx, d, meta)...)
+}
+
+func resourceGeneratedPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	output, err := findGeneratedPolicyByJobID(ctx, conn, d.Id(), d.Get("include_resource_placeholders").(bool), d.Get("include_service_level_template").(bool))
+
+	// Policy generation jobs and the policies they generated expire after 7 days.
+	// Keep the last known policies of a job that succeeded, so that they can still be referenced.
+	if !d.IsNewResource() && tfresource.NotFound(err) && d.Get("status").(string) == string(types.JobStatusSucceeded) {
+		log.Printf("[WARN] IAM Access Analyzer Generated Policy (%s) has expired, keeping last known policies", d.Id())
+		d.Set("expired", true)
+		return diags
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Access Analyzer Generated Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Access Analyzer Generated Policy (%s): %s", d.Id(), err)
+	}
+
+	jobDetails := output.JobDetails
+	if v := jobDetails.CompletedOn; v != nil {
+		d.Set("completed_on", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set("completed_on", nil)
+	}
+	d.Set("expired", false)
+	d.Set("started_on", aws.ToTime(jobDetails.StartedOn).Format(time.RFC3339))
+	d.Set("status", jobDetails.Status)
+
+	var policies []string
+	if result := output.GeneratedPolicyResult; result != nil {
+		for _, v := range result.GeneratedPolicies {
+			policies = append(policies, aws.ToString(v.Policy))
+		}
+
+		if v := result.Properties; v != nil {
+			// Only set on import, as the API returns the defaulted end time when none was configured.
+			if _, ok := d.GetOk("cloudtrail_details"); !ok && v.CloudTrailProperties != nil {
+				if err := d.Set("cloudtrail_details", []interface{}{flattenCloudTrailProperties(v.CloudTrailProperties)}); err != nil {
+					return sdkdiag.AppendErrorf(diags, "setting cloudtrail_details: %s", err)
+				}
+			}
+			d.Set("is_complete", v.IsComplete)
+			d.Set("principal_arn", v.PrincipalArn)
+		}
+	}
+	d.Set("policies", policies)
+
+	return diags
+}
+
+func resourceGeneratedPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// include_resource_placeholders and include_service_level_template only change how the generated policies are read.
+
+	return append(diags, resourceGeneratedPolicyRead(ctx, d, meta)...)
+}
+
+func resourceGeneratedPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	// Generated policies can't be deleted, only policy generation jobs that are in progress can be canceled.
+	output, err := findGeneratedPolicyByJobID(ctx, conn, d.Id(), false, false)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Access Analyzer Generated Policy (%s): %s", d.Id(), err)
+	}
+
+	if output.JobDetails.Status != types.JobStatusInProgress {
+		log.Printf("[DEBUG] IAM Access Analyzer Generated Policy (%s) is %s, removing from state", d.Id(), output.JobDetails.Status)
+		return diags
+	}
+
+	log.Printf("[DEBUG] Canceling IAM Access Analyzer policy generation: %s", d.Id())
+	_, err = conn.CancelPolicyGeneration(ctx, &accessanalyzer.CancelPolicyGenerationInput{
+		JobId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "canceling IAM Access Analyzer policy generation (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findGeneratedPolicyByJobID(ctx context.Context, conn *accessanalyzer.Client, id string, includeResourcePlaceholders, includeServiceLevelTemplate bool) (*accessanalyzer.GetGeneratedPolicyOutput, error) {
+	input := &accessanalyzer.GetGeneratedPolicyInput{
+		IncludeResourcePlaceholders: aws.Bool(includeResourcePlaceholders),
+		IncludeServiceLevelTemplate: aws.Bool(includeServiceLevelTemplate),
+		JobId:                       aws.String(id),
+	}
+
+	output, err := conn.GetGeneratedPolicy(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.JobDetails == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusPolicyGeneration(ctx context.Context, conn *accessanalyzer.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findGeneratedPolicyByJobID(ctx, conn, id, false, false)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.JobDetails.Status), nil
+	}
+}
+
+func waitPolicyGenerationSucceeded(ctx context.Context, conn *accessanalyzer.Client, id string, timeout time.Duration) (*accessanalyzer.GetGeneratedPolicyOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.JobStatusInProgress),
+		Target:     enum.Slice(types.JobStatusSucceeded),
+		Refresh:    statusPolicyGeneration(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*accessanalyzer.GetGeneratedPolicyOutput); ok {
+		if v := output.JobDetails.JobError; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandCloudTrailDetails(tfMap map[string]interface{}) *types.CloudTrailDetails {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.CloudTrailDetails{}
+
+	if v, ok := tfMap["access_role"].(string); ok && v != "" {
+		apiObject.AccessRole = aws.String(v)
+	}
+
+	if v, ok := tfMap["end_time"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.EndTime = aws.Time(v)
+	}
+
+	if v, ok := tfMap["start_time"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.StartTime = aws.Time(v)
+	}
+
+	if v, ok := tfMap["trail"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			trail := types.Trail{}
+
+			if v, ok := tfMap["all_regions"].(bool); ok {
+				trail.AllRegions = aws.Bool(v)
+			}
+
+			if v, ok := tfMap["cloudtrail_arn"].(string); ok && v != "" {
+				trail.CloudTrailArn = aws.String(v)
+			}
+
+			if v, ok := tfMap["regions"].(*schema.Set); ok && v.Len() > 0 {
+				trail.Regions = flex.ExpandStringValueSet(v)
+			}
+
+			apiObject.Trails = append(apiObject.Trails, trail)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenCloudTrailProperties(apiObject *types.CloudTrailProperties) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EndTime; v != nil {
+		tfMap["end_time"] = aws.ToTime(v).UTC().Format(time.RFC3339)
+	}
+
+	if v := apiObject.StartTime; v != nil {
+		tfMap["start_time"] = aws.ToTime(v).UTC().Format(time.RFC3339)
+	}
+
+	var trails []interface{}
+	for _, v := range apiObject.TrailProperties {
+		trails = append(trails, map[string]interface{}{
+			"all_regions":    aws.ToBool(v.AllRegions),
+			"cloudtrail_arn": aws.ToString(v.CloudTrailArn),
+			"regions":        flex.FlattenStringValueSet(v.Regions),
+		})
+	}
+	tfMap["trail"] = trails
+
+	return tfMap
+}
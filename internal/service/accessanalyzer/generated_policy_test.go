@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfaccessanalyzer "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAccessAnalyzerGeneratedPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var generatedPolicy accessanalyzer.GetGeneratedPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_accessanalyzer_generated_policy.test"
+	startTime := time.Now().UTC().Add(-24 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AccessAnalyzerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// Generated policies can't be deleted.
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGeneratedPolicyConfig_basic(rName, startTime, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeneratedPolicyExists(ctx, resourceName, &generatedPolicy),
+					resource.TestCheckResourceAttr(resourceName, "cloudtrail_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cloudtrail_details.0.start_time", startTime),
+					resource.TestCheckResourceAttr(resourceName, "cloudtrail_details.0.trail.#", "1"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "completed_on"),
+					resource.TestCheckResourceAttr(resourceName, "expired", "false"),
+					resource.TestCheckResourceAttr(resourceName, "include_resource_placeholders", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "is_complete"),
+					resource.TestCheckResourceAttrPair(resourceName, "principal_arn", "aws_iam_role.principal", "arn"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "started_on"),
+					resource.TestCheckResourceAttr(resourceName, "status", "SUCCEEDED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The access role and end time are not returned by the API,
+				// and the include_* arguments only change how the policies are read.
+				ImportStateVerifyIgnore: []string{"cloudtrail_details", "include_resource_placeholders", "include_service_level_template"},
+			},
+			{
+				Config: testAccGeneratedPolicyConfig_basic(rName, startTime, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeneratedPolicyExists(ctx, resourceName, &generatedPolicy),
+					resource.TestCheckResourceAttr(resourceName, "include_resource_placeholders", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "SUCCEEDED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGeneratedPolicyExists(ctx context.Context, n string, v *accessanalyzer.GetGeneratedPolicyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IAM Access Analyzer Generated Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+		output, err := tfaccessanalyzer.FindGeneratedPolicyByJobID(ctx, conn, rs.Primary.ID, false, false)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccGeneratedPolicyConfig_basic(rName, startTime string, includeResourcePlaceholders bool) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect    = "Allow"
+        Principal = { Service = "cloudtrail.${data.aws_partition.current.dns_suffix}" }
+        Action    = "s3:GetBucketAcl"
+        Resource  = aws_s3_bucket.test.arn
+      },
+      {
+        Effect    = "Allow"
+        Principal = { Service = "cloudtrail.${data.aws_partition.current.dns_suffix}" }
+        Action    = "s3:PutObject"
+        Resource  = "${aws_s3_bucket.test.arn}/*"
+        Condition = { StringEquals = { "s3:x-amz-acl" = "bucket-owner-full-control" } }
+      },
+    ]
+  })
+}
+
+resource "aws_cloudtrail" "test" {
+  # Must have bucket policy attached first
+  depends_on = [aws_s3_bucket_policy.test]
+
+  name           = %[1]q
+  s3_bucket_name = aws_s3_bucket.test.id
+}
+
+resource "aws_iam_role" "principal" {
+  name = "%[1]s-principal"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Service = "ec2.${data.aws_partition.current.dns_suffix}" }
+      Action    = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role" "access" {
+  name = "%[1]s-access"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Service = "access-analyzer.${data.aws_partition.current.dns_suffix}" }
+      Action    = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "access" {
+  name = %[1]q
+  role = aws_iam_role.access.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect   = "Allow"
+        Action   = ["cloudtrail:GetTrail", "cloudtrail:ListTrails", "iam:GetRole", "iam:GetServiceLastAccessedDetails", "iam:GenerateServiceLastAccessedDetails"]
+        Resource = "*"
+      },
+      {
+        Effect   = "Allow"
+        Action   = ["s3:GetObject", "s3:ListBucket"]
+        Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+      },
+    ]
+  })
+}
+
+resource "aws_accessanalyzer_generated_policy" "test" {
+  principal_arn                 = aws_iam_role.principal.arn
+  include_resource_placeholders = %[3]t
+
+  cloudtrail_details {
+    access_role = aws_iam_role.access.arn
+    start_time  = %[2]q
+
+    trail {
+      cloudtrail_arn = aws_cloudtrail.test.arn
+      all_regions    = true
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.access]
+}
+`, rName, startTime, includeResourcePlaceholders)
+}
@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+const (
+	// policyValidationErrorSeverityNone reports all findings as warnings.
+	policyValidationErrorSeverityNone = "NONE"
+)
+
+// policyValidationFindingSeverity orders finding types from least to most severe.
+var policyValidationFindingSeverity = map[types.ValidatePolicyFindingType]int{
+	types.ValidatePolicyFindingTypeSuggestion:      1,
+	types.ValidatePolicyFindingTypeWarning:         2,
+	types.ValidatePolicyFindingTypeSecurityWarning: 3,
+	types.ValidatePolicyFindingTypeError:           4,
+}
+
+// @SDKDataSource("aws_accessanalyzer_policy_validation")
+func dataSourcePolicyValidation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePolicyValidationRead,
+
+		Schema: map[string]*schema.Schema{
+			"error_severity": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  types.ValidatePolicyFindingTypeError,
+				ValidateFunc: validation.StringInSlice(append(
+					enum.Slice(types.ValidatePolicyFindingType("").Values()...),
+					policyValidationErrorSeverityNone,
+				), false),
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"finding_details": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finding_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issue_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"learn_more_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"locations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"end_column": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"end_line": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"path": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"start_column": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"start_line": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"locale": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.Locale](),
+			},
+			"policy_document": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"policy_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.PolicyType](),
+			},
+			"validate_policy_resource_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ValidatePolicyResourceType](),
+			},
+		},
+	}
+}
+
+func dataSourcePolicyValidationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	policyDocument := d.Get("policy_document").(string)
+	input := &accessanalyzer.ValidatePolicyInput{
+		PolicyDocument: aws.String(policyDocument),
+		PolicyType:     types.PolicyType(d.Get("policy_type").(string)),
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		input.Locale = types.Locale(v.(string))
+	}
+
+	if v, ok := d.GetOk("validate_policy_resource_type"); ok {
+		input.ValidatePolicyResourceType = types.ValidatePolicyResourceType(v.(string))
+	}
+
+	findings, err := findPolicyValidationFindings(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "validating IAM Access Analyzer policy: %s", err)
+	}
+
+	errorSeverity := policyValidationFindingSeverity[types.ValidatePolicyFindingType(d.Get("error_severity").(string))]
+
+	for _, finding := range findings {
+		format := "IAM Access Analyzer policy validation %s (%s)%s: %s"
+		args := []any{finding.FindingType, aws.ToString(finding.IssueCode), policyValidationFindingPaths(finding.Locations), aws.ToString(finding.FindingDetails)}
+
+		if severity := policyValidationFindingSeverity[finding.FindingType]; errorSeverity > 0 && severity >= errorSeverity {
+			diags = sdkdiag.AppendErrorf(diags, format, args...)
+		} else {
+			diags = sdkdiag.AppendWarningf(diags, format, args...)
+		}
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(string(input.PolicyType) + policyDocument)))
+	if err := d.Set("findings", flattenValidatePolicyFindings(findings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting findings: %s", err)
+	}
+
+	return diags
+}
+
+func findPolicyValidationFindings(ctx context.Context, conn *accessanalyzer.Client, input *accessanalyzer.ValidatePolicyInput) ([]types.ValidatePolicyFinding, error) {
+	var output []types.ValidatePolicyFinding
+
+	pages := accessanalyzer.NewValidatePolicyPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Findings...)
+	}
+
+	return output, nil
+}
+
+func flattenValidatePolicyFindings(apiObjects []types.ValidatePolicyFinding) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		var tfLocations []interface{}
+
+		for _, location := range apiObject.Locations {
+			tfLocation := map[string]interface{}{
+				"path": policyValidationPath(location.Path),
+			}
+
+			if span := location.Span; span != nil {
+				if v := span.Start; v != nil {
+					tfLocation["start_column"] = aws.ToInt32(v.Column)
+					tfLocation["start_line"] = aws.ToInt32(v.Line)
+				}
+				if v := span.End; v != nil {
+					tfLocation["end_column"] = aws.ToInt32(v.Column)
+					tfLocation["end_line"] = aws.ToInt32(v.Line)
+				}
+			}
+
+			tfLocations = append(tfLocations, tfLocation)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"finding_details": aws.ToString(apiObject.FindingDetails),
+			"finding_type":    apiObject.FindingType,
+			"issue_code":      aws.ToString(apiObject.IssueCode),
+			"learn_more_link": aws.ToString(apiObject.LearnMoreLink),
+			"locations":       tfLocations,
+		})
+	}
+
+	return tfList
+}
+
+// policyValidationFindingPaths returns the paths of the locations of a finding for use in diagnostics.
+func policyValidationFindingPaths(locations []types.Location) string {
+	var paths []string
+
+	for _, location := range locations {
+		if path := policyValidationPath(location.Path); path != "" {
+			paths = append(paths, path)
+		}
+	}
+
+	if len(paths) == 0 {
+		return ""
+	}
+
+	return " at " + strings.Join(paths, ", ")
+}
+
+// policyValidationPath returns a path like "Statement[0].Action[1]" for the specified path elements.
+func policyValidationPath(apiObjects []types.PathElement) string {
+	var sb strings.Builder
+
+	for _, apiObject := range apiObjects {
+		switch v := apiObject.(type) {
+		case *types.PathElementMemberIndex:
+			fmt.Fprintf(&sb, "[%d]", v.Value)
+		case *types.PathElementMemberKey:
+			if sb.Len() > 0 {
+				sb.WriteString(".")
+			}
+			sb.WriteString(v.Value)
+		case *types.PathElementMemberSubstring:
+			start := aws.ToInt32(v.Value.Start)
+			fmt.Fprintf(&sb, "[%d:%d]", start, start+aws.ToInt32(v.Value.Length))
+		case *types.PathElementMemberValue:
+			fmt.Fprintf(&sb, "[%q]", v.Value)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,726 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_cloudwatch_dashboard_document")
+func DataSourceDashboardDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDashboardDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"end": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"period_override": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"auto", "inherit"}, false),
+			},
+			"source_dashboard_documents": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+			"start": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"widget": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_status": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"alarms": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+									"sort_by": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"default", "stateUpdatedTimestamp", "timestamp"}, false),
+									},
+									"states": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(cloudwatch.StateValue_Values(), false),
+										},
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"explorer": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"label": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"metric": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"metric_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"resource_type": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"stat": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"period": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntDivisibleBy(60),
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"rows_per_page": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"split_by": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "timeSeries",
+										ValidateFunc: validation.StringInSlice([]string{"bar", "pie", "timeSeries"}, false),
+									},
+									"widgets_per_row": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 4),
+									},
+								},
+							},
+						},
+						"height": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      dashboardWidgetDefaultHeight,
+							ValidateFunc: validation.IntBetween(1, 1000),
+						},
+						"log": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_group_names": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 50,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"query": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "table",
+										ValidateFunc: validation.StringInSlice([]string{"bar", "pie", "table", "timeSeries"}, false),
+									},
+								},
+							},
+						},
+						"metric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"account_id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"color": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"dimensions": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"expression": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"label": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"metric_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"namespace": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"period": {
+													Type:     schema.TypeInt,
+													Optional: true,
+													ValidateFunc: validation.Any(
+														validation.IntInSlice([]int{1, 5, 10, 30}),
+														validation.IntDivisibleBy(60),
+													),
+												},
+												"region": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"stat": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"visible": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  true,
+												},
+												"y_axis": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice([]string{"left", "right"}, false),
+												},
+											},
+										},
+									},
+									"period": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ValidateFunc: validation.Any(
+											validation.IntInSlice([]int{1, 5, 10, 30}),
+											validation.IntDivisibleBy(60),
+										),
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"stat": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "timeSeries",
+										ValidateFunc: validation.StringInSlice([]string{"bar", "gauge", "pie", "singleValue", "timeSeries"}, false),
+									},
+								},
+							},
+						},
+						"position": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"x": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(0, dashboardGridWidth-1),
+									},
+									"y": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+								},
+							},
+						},
+						"text": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"background": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"solid", "transparent"}, false),
+									},
+									"markdown": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"width": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      dashboardWidgetDefaultWidth,
+							ValidateFunc: validation.IntBetween(1, dashboardGridWidth),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDashboardDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	region := meta.(*conns.AWSClient).Region
+
+	doc := &dashboardDoc{}
+
+	for i, v := range d.Get("source_dashboard_documents").([]interface{}) {
+		if v == nil {
+			continue
+		}
+
+		sourceDoc, err := parseDashboardDoc(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing CloudWatch Dashboard Document: source document %d: %s", i, err)
+		}
+
+		doc.Merge(sourceDoc)
+	}
+
+	widgets, err := expandDashboardWidgets(d.Get("widget").([]interface{}), region)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing CloudWatch Dashboard Document: %s", err)
+	}
+
+	doc.Merge(&dashboardDoc{
+		End:            d.Get("end").(string),
+		PeriodOverride: d.Get("period_override").(string),
+		Start:          d.Get("start").(string),
+		Widgets:        widgets,
+	})
+	doc.Layout()
+
+	jsonString, err := doc.String()
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing CloudWatch Dashboard Document: %s", err)
+	}
+
+	d.Set("json", jsonString)
+
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return diags
+}
+
+func expandDashboardWidgets(tfList []interface{}, region string) ([]*dashboardWidget, error) {
+	var apiObjects []*dashboardWidget
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &dashboardWidget{
+			Height: tfMap["height"].(int),
+			Width:  tfMap["width"].(int),
+		}
+
+		if v, ok := tfMap["position"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.X = aws.Int(tfMap["x"].(int))
+			apiObject.Y = aws.Int(tfMap["y"].(int))
+		}
+
+		var types []string
+		for _, typ := range []string{"alarm_status", "explorer", "log", "metric", "text"} {
+			if v, ok := tfMap[typ].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				types = append(types, typ)
+			}
+		}
+
+		if len(types) != 1 {
+			return nil, fmt.Errorf("widget %d: exactly one of alarm_status, explorer, log, metric or text must be specified", i)
+		}
+
+		tfMap = tfMap[types[0]].([]interface{})[0].(map[string]interface{})
+
+		switch types[0] {
+		case "alarm_status":
+			apiObject.Type = dashboardWidgetTypeAlarm
+			apiObject.Properties = expandDashboardAlarmStatusWidgetProperties(tfMap)
+		case "explorer":
+			apiObject.Type = dashboardWidgetTypeExplorer
+			apiObject.Properties = expandDashboardExplorerWidgetProperties(tfMap, region)
+		case "log":
+			apiObject.Type = dashboardWidgetTypeLog
+			apiObject.Properties = expandDashboardLogWidgetProperties(tfMap, region)
+		case "metric":
+			properties, err := expandDashboardMetricWidgetProperties(tfMap, region)
+
+			if err != nil {
+				return nil, fmt.Errorf("widget %d: %w", i, err)
+			}
+
+			apiObject.Type = dashboardWidgetTypeMetric
+			apiObject.Properties = properties
+		case "text":
+			apiObject.Type = dashboardWidgetTypeText
+			apiObject.Properties = expandDashboardTextWidgetProperties(tfMap)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+func expandDashboardAlarmStatusWidgetProperties(tfMap map[string]interface{}) map[string]interface{} {
+	apiObject := map[string]interface{}{
+		"alarms": flex.ExpandStringValueList(tfMap["alarms"].([]interface{})),
+	}
+
+	if v, ok := tfMap["sort_by"].(string); ok && v != "" {
+		apiObject["sortBy"] = v
+	}
+
+	if v, ok := tfMap["states"].(*schema.Set); ok && v.Len() > 0 {
+		states := flex.ExpandStringValueSet(v)
+		sort.Strings(states)
+		apiObject["states"] = states
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		apiObject["title"] = v
+	}
+
+	return apiObject
+}
+
+func expandDashboardExplorerWidgetProperties(tfMap map[string]interface{}, region string) map[string]interface{} {
+	var metrics []interface{}
+	for _, tfMapRaw := range tfMap["metric"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		metrics = append(metrics, map[string]interface{}{
+			"metricName":   tfMap["metric_name"].(string),
+			"resourceType": tfMap["resource_type"].(string),
+			"stat":         tfMap["stat"].(string),
+		})
+	}
+
+	labels := []interface{}{}
+	for _, tfMapRaw := range tfMap["label"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		label := map[string]interface{}{
+			"key": tfMap["key"].(string),
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			label["value"] = v
+		}
+
+		labels = append(labels, label)
+	}
+
+	widgetOptions := map[string]interface{}{
+		"stacked": tfMap["stacked"].(bool),
+		"view":    tfMap["view"].(string),
+	}
+
+	if v, ok := tfMap["rows_per_page"].(int); ok && v != 0 {
+		widgetOptions["rowsPerPage"] = v
+	}
+
+	if v, ok := tfMap["widgets_per_row"].(int); ok && v != 0 {
+		widgetOptions["widgetsPerRow"] = v
+	}
+
+	apiObject := map[string]interface{}{
+		"labels":        labels,
+		"metrics":       metrics,
+		"region":        dashboardWidgetRegion(tfMap, region),
+		"widgetOptions": widgetOptions,
+	}
+
+	if v, ok := tfMap["period"].(int); ok && v != 0 {
+		apiObject["period"] = v
+	}
+
+	if v, ok := tfMap["split_by"].(string); ok && v != "" {
+		apiObject["splitBy"] = v
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		apiObject["title"] = v
+	}
+
+	return apiObject
+}
+
+func expandDashboardLogWidgetProperties(tfMap map[string]interface{}, region string) map[string]interface{} {
+	var query []string
+	for _, v := range flex.ExpandStringValueList(tfMap["log_group_names"].([]interface{})) {
+		query = append(query, fmt.Sprintf("SOURCE '%s'", v))
+	}
+	query = append(query, tfMap["query"].(string))
+
+	apiObject := map[string]interface{}{
+		"query":   strings.Join(query, " | "),
+		"region":  dashboardWidgetRegion(tfMap, region),
+		"stacked": tfMap["stacked"].(bool),
+		"view":    tfMap["view"].(string),
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		apiObject["title"] = v
+	}
+
+	return apiObject
+}
+
+func expandDashboardMetricWidgetProperties(tfMap map[string]interface{}, region string) (map[string]interface{}, error) {
+	var metrics []interface{}
+	for i, tfMapRaw := range tfMap["metric"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		metric, err := expandDashboardMetric(tfMap)
+
+		if err != nil {
+			return nil, fmt.Errorf("metric %d: %w", i, err)
+		}
+
+		metrics = append(metrics, metric)
+	}
+
+	apiObject := map[string]interface{}{
+		"metrics": metrics,
+		"region":  dashboardWidgetRegion(tfMap, region),
+		"stacked": tfMap["stacked"].(bool),
+		"view":    tfMap["view"].(string),
+	}
+
+	if v, ok := tfMap["period"].(int); ok && v != 0 {
+		apiObject["period"] = v
+	}
+
+	if v, ok := tfMap["stat"].(string); ok && v != "" {
+		apiObject["stat"] = v
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		apiObject["title"] = v
+	}
+
+	return apiObject, nil
+}
+
+// expandDashboardMetric returns a metric array of a metric widget: the namespace, the metric name,
+// the names and values of the dimensions and the rendering options.
+// For metric math expressions the array contains only the rendering options.
+func expandDashboardMetric(tfMap map[string]interface{}) ([]interface{}, error) {
+	options := map[string]interface{}{}
+
+	for k, key := range map[string]string{
+		"account_id": "accountId",
+		"color":      "color",
+		"id":         "id",
+		"label":      "label",
+		"region":     "region",
+		"stat":       "stat",
+		"y_axis":     "yAxis",
+	} {
+		if v, ok := tfMap[k].(string); ok && v != "" {
+			options[key] = v
+		}
+	}
+
+	if v, ok := tfMap["period"].(int); ok && v != 0 {
+		options["period"] = v
+	}
+
+	if v, ok := tfMap["visible"].(bool); ok && !v {
+		options["visible"] = false
+	}
+
+	expression, _ := tfMap["expression"].(string)
+	metricName, _ := tfMap["metric_name"].(string)
+	namespace, _ := tfMap["namespace"].(string)
+	dimensions, _ := tfMap["dimensions"].(map[string]interface{})
+
+	if expression != "" {
+		if metricName != "" || namespace != "" || len(dimensions) > 0 {
+			return nil, fmt.Errorf("expression cannot be specified with metric_name, namespace or dimensions")
+		}
+
+		options["expression"] = expression
+
+		return []interface{}{options}, nil
+	}
+
+	if metricName == "" || namespace == "" {
+		return nil, fmt.Errorf("one of expression or metric_name and namespace must be specified")
+	}
+
+	apiObject := []interface{}{namespace, metricName}
+
+	// Order the dimensions by name for a stable document.
+	names := make([]string, 0, len(dimensions))
+	for k := range dimensions {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	for _, k := range names {
+		apiObject = append(apiObject, k, dimensions[k].(string))
+	}
+
+	if len(options) > 0 {
+		apiObject = append(apiObject, options)
+	}
+
+	return apiObject, nil
+}
+
+func expandDashboardTextWidgetProperties(tfMap map[string]interface{}) map[string]interface{} {
+	apiObject := map[string]interface{}{
+		"markdown": tfMap["markdown"].(string),
+	}
+
+	if v, ok := tfMap["background"].(string); ok && v != "" {
+		apiObject["background"] = v
+	}
+
+	return apiObject
+}
+
+// dashboardWidgetRegion returns the region of a widget, defaulting to the provider's region.
+func dashboardWidgetRegion(tfMap map[string]interface{}, region string) string {
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		return v
+	}
+
+	return region
+}
@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudWatchDashboardDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_dashboard_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", testAccDashboardDocumentExpectedJSON_basic(acctest.Region())),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_sourceDocuments(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_dashboard_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_sourceDocuments,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", `{"periodOverride":"inherit","start":"-PT3H","widgets":[{"height":2,"properties":{"markdown":"# Header"},"type":"text","width":24,"x":0,"y":0},{"height":6,"properties":{"markdown":"Left"},"type":"text","width":12,"x":0,"y":2},{"height":6,"properties":{"background":"transparent","markdown":"Right"},"type":"text","width":12,"x":12,"y":2}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_invalidWidget(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_invalidWidget,
+				ExpectError: regexp.MustCompile(`exactly one of alarm_status, explorer, log, metric or text must be specified`),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_dashboard(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cloudwatch_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_dashboard(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "dashboard_body", "data.aws_cloudwatch_dashboard_document.test", "json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDashboardDocumentExpectedJSON_basic(region string) string {
+	return fmt.Sprintf(`{"widgets":[`+
+		`{"height":6,"properties":{"metrics":[["AWS/EC2","CPUUtilization","InstanceId","i-abcd1234",{"id":"cpu","stat":"Maximum"}],[{"expression":"cpu * 2","label":"Double"}]],"period":300,"region":%[1]q,"stacked":false,"title":"CPU","view":"timeSeries"},"type":"metric","width":12,"x":0,"y":0},`+
+		`{"height":6,"properties":{"query":"SOURCE '/aws/lambda/a' | SOURCE '/aws/lambda/b' | fields @timestamp, @message | limit 20","region":%[1]q,"stacked":false,"view":"table"},"type":"log","width":12,"x":12,"y":0},`+
+		`{"height":6,"properties":{"alarms":["arn:aws:cloudwatch:us-west-2:123456789012:alarm:test"],"sortBy":"stateUpdatedTimestamp","states":["ALARM","OK"],"title":"Alarms"},"type":"alarm","width":6,"x":0,"y":6},`+
+		`{"height":6,"properties":{"labels":[{"key":"Environment","value":"test"}],"metrics":[{"metricName":"CPUUtilization","resourceType":"AWS::EC2::Instance","stat":"Average"}],"region":%[1]q,"splitBy":"AutoScalingGroupName","widgetOptions":{"rowsPerPage":2,"stacked":false,"view":"timeSeries","widgetsPerRow":2}},"type":"explorer","width":18,"x":6,"y":6}`+
+		`]}`, region)
+}
+
+const testAccDashboardDocumentDataSourceConfig_basic = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    width = 12
+
+    metric {
+      period = 300
+      title  = "CPU"
+
+      metric {
+        id          = "cpu"
+        metric_name = "CPUUtilization"
+        namespace   = "AWS/EC2"
+        stat        = "Maximum"
+
+        dimensions = {
+          InstanceId = "i-abcd1234"
+        }
+      }
+
+      metric {
+        expression = "cpu * 2"
+        label      = "Double"
+      }
+    }
+  }
+
+  widget {
+    width = 12
+
+    log {
+      log_group_names = ["/aws/lambda/a", "/aws/lambda/b"]
+      query           = "fields @timestamp, @message | limit 20"
+    }
+  }
+
+  widget {
+    alarm_status {
+      alarms  = ["arn:aws:cloudwatch:us-west-2:123456789012:alarm:test"]
+      sort_by = "stateUpdatedTimestamp"
+      states  = ["OK", "ALARM"]
+      title   = "Alarms"
+    }
+  }
+
+  widget {
+    width = 18
+
+    explorer {
+      rows_per_page   = 2
+      split_by        = "AutoScalingGroupName"
+      widgets_per_row = 2
+
+      label {
+        key   = "Environment"
+        value = "test"
+      }
+
+      metric {
+        metric_name   = "CPUUtilization"
+        resource_type = "AWS::EC2::Instance"
+        stat          = "Average"
+      }
+    }
+  }
+}
+`
+
+const testAccDashboardDocumentDataSourceConfig_sourceDocuments = `
+data "aws_cloudwatch_dashboard_document" "source" {
+  start = "-PT6H"
+
+  widget {
+    height = 2
+    width  = 24
+
+    text {
+      markdown = "# Header"
+    }
+  }
+}
+
+data "aws_cloudwatch_dashboard_document" "test" {
+  source_dashboard_documents = [data.aws_cloudwatch_dashboard_document.source.json]
+
+  period_override = "inherit"
+  start           = "-PT3H"
+
+  widget {
+    width = 12
+
+    text {
+      markdown = "Left"
+    }
+  }
+
+  widget {
+    width = 12
+
+    text {
+      background = "transparent"
+      markdown   = "Right"
+    }
+  }
+}
+`
+
+const testAccDashboardDocumentDataSourceConfig_invalidWidget = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    text {
+      markdown = "Text"
+    }
+
+    log {
+      log_group_names = ["/aws/lambda/a"]
+      query           = "fields @message"
+    }
+  }
+}
+`
+
+func testAccDashboardDocumentDataSourceConfig_dashboard(rName string) string {
+	return fmt.Sprintf(`
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    width = 24
+
+    text {
+      markdown = "# %[1]s"
+    }
+  }
+
+  widget {
+    width = 12
+
+    metric {
+      metric {
+        metric_name = "CPUUtilization"
+        namespace   = "AWS/EC2"
+        stat        = "Average"
+      }
+    }
+  }
+}
+
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = %[1]q
+  dashboard_body = data.aws_cloudwatch_dashboard_document.test.json
+}
+`, rName)
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+const (
+	// dashboardGridWidth is the number of columns in the dashboard grid.
+	dashboardGridWidth = 24
+
+	dashboardWidgetDefaultHeight = 6
+	dashboardWidgetDefaultWidth  = 6
+)
+
+const (
+	dashboardWidgetTypeAlarm    = "alarm"
+	dashboardWidgetTypeExplorer = "explorer"
+	dashboardWidgetTypeLog      = "log"
+	dashboardWidgetTypeMetric   = "metric"
+	dashboardWidgetTypeText     = "text"
+)
+
+// dashboardDoc is a CloudWatch dashboard body.
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html.
+type dashboardDoc struct {
+	End            string             `json:"end,omitempty"`
+	PeriodOverride string             `json:"periodOverride,omitempty"`
+	Start          string             `json:"start,omitempty"`
+	Widgets        []*dashboardWidget `json:"widgets"`
+}
+
+type dashboardWidget struct {
+	Height     int         `json:"height"`
+	Properties interface{} `json:"properties,omitempty"`
+	Type       string      `json:"type"`
+	Width      int         `json:"width"`
+	X          *int        `json:"x"`
+	Y          *int        `json:"y"`
+}
+
+// Merge appends the widgets of the specified document to the document.
+// The time range and period override of the specified document take precedence.
+func (d *dashboardDoc) Merge(other *dashboardDoc) {
+	if other.End != "" {
+		d.End = other.End
+	}
+	if other.PeriodOverride != "" {
+		d.PeriodOverride = other.PeriodOverride
+	}
+	if other.Start != "" {
+		d.Start = other.Start
+	}
+
+	d.Widgets = append(d.Widgets, other.Widgets...)
+}
+
+// Layout positions the widgets that have no position on the grid.
+// Positioned widgets keep their positions. The remaining widgets are placed below them,
+// from left to right and then top to bottom, in the order of the widgets.
+func (d *dashboardDoc) Layout() {
+	var top int
+
+	for _, widget := range d.Widgets {
+		if widget.Height == 0 {
+			widget.Height = dashboardWidgetDefaultHeight
+		}
+		if widget.Width == 0 {
+			widget.Width = dashboardWidgetDefaultWidth
+		}
+
+		if widget.X != nil && widget.Y != nil && *widget.Y+widget.Height > top {
+			top = *widget.Y + widget.Height
+		}
+	}
+
+	var x, rowHeight int
+	y := top
+
+	for _, widget := range d.Widgets {
+		if widget.X != nil && widget.Y != nil {
+			continue
+		}
+
+		if x > 0 && x+widget.Width > dashboardGridWidth {
+			x = 0
+			y += rowHeight
+			rowHeight = 0
+		}
+
+		widget.X = aws.Int(x)
+		widget.Y = aws.Int(y)
+
+		x += widget.Width
+		if widget.Height > rowHeight {
+			rowHeight = widget.Height
+		}
+	}
+}
+
+func (d *dashboardDoc) String() (string, error) {
+	if d.Widgets == nil {
+		d.Widgets = []*dashboardWidget{}
+	}
+
+	b, err := json.Marshal(d)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func parseDashboardDoc(s string) (*dashboardDoc, error) {
+	var doc dashboardDoc
+
+	if err := json.Unmarshal([]byte(s), &doc); err != nil {
+		return nil, fmt.Errorf("parsing dashboard document: %w", err)
+	}
+
+	for i, widget := range doc.Widgets {
+		if widget == nil {
+			return nil, fmt.Errorf("parsing dashboard document: widget %d is null", i)
+		}
+
+		if (widget.X == nil) != (widget.Y == nil) {
+			return nil, fmt.Errorf("parsing dashboard document: widget %d must specify both x and y or neither", i)
+		}
+	}
+
+	return &doc, nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"testing"
+)
+
+func TestDashboardDocLayout(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected string
+	}{
+		"empty": {
+			input:    `{}`,
+			expected: `{"widgets":[]}`,
+		},
+		"default size": {
+			input:    `{"widgets":[{"type":"text"},{"type":"text"}]}`,
+			expected: `{"widgets":[{"height":6,"type":"text","width":6,"x":0,"y":0},{"height":6,"type":"text","width":6,"x":6,"y":0}]}`,
+		},
+		"wrap": {
+			input:    `{"widgets":[{"type":"text","width":12,"height":3},{"type":"text","width":12,"height":4},{"type":"text","width":6}]}`,
+			expected: `{"widgets":[{"height":3,"type":"text","width":12,"x":0,"y":0},{"height":4,"type":"text","width":12,"x":12,"y":0},{"height":6,"type":"text","width":6,"x":0,"y":4}]}`,
+		},
+		"below positioned": {
+			input:    `{"widgets":[{"type":"text","width":24,"height":2,"x":0,"y":0},{"type":"text","width":24,"height":3,"x":0,"y":4},{"type":"text"}]}`,
+			expected: `{"widgets":[{"height":2,"type":"text","width":24,"x":0,"y":0},{"height":3,"type":"text","width":24,"x":0,"y":4},{"height":6,"type":"text","width":6,"x":0,"y":7}]}`,
+		},
+		"properties": {
+			input:    `{"start":"-PT3H","widgets":[{"type":"text","properties":{"markdown":"# Title"}}]}`,
+			expected: `{"start":"-PT3H","widgets":[{"height":6,"properties":{"markdown":"# Title"},"type":"text","width":6,"x":0,"y":0}]}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := parseDashboardDoc(testCase.input)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			doc.Layout()
+
+			got, err := doc.String()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestDashboardDocMerge(t *testing.T) {
+	t.Parallel()
+
+	doc, err := parseDashboardDoc(`{"start":"-PT6H","periodOverride":"auto","widgets":[{"type":"text","x":0,"y":0,"width":24,"height":1}]}`)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	other, err := parseDashboardDoc(`{"start":"-PT1H","widgets":[{"type":"metric"}]}`)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	doc.Merge(other)
+	doc.Layout()
+
+	got, err := doc.String()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"periodOverride":"auto","start":"-PT1H","widgets":[{"height":1,"type":"text","width":24,"x":0,"y":0},{"height":6,"type":"metric","width":6,"x":0,"y":1}]}`
+
+	if got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestParseDashboardDoc(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{
+		`[]`,
+		`{"widgets":[null]}`,
+		`{"widgets":[{"type":"text","x":0}]}`,
+	} {
+		if _, err := parseDashboardDoc(input); err == nil {
+			t.Errorf("expected error for %s", input)
+		}
+	}
+}
@@ -0,0 +1,383 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_cloudwatch_metric_data")
+func DataSourceMetricData() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMetricDataRead,
+
+		Schema: map[string]*schema.Schema{
+			"end_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidUTCTimestamp,
+			},
+			"max_datapoints": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"metric_query": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 500,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"account_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"expression": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+						"metric": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimensions": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"metric_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"namespace": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 255),
+											validation.StringMatch(regexp.MustCompile(`[^:].*`), "must not contain colon characters"),
+										),
+									},
+									"period": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ValidateFunc: validation.Any(
+											validation.IntInSlice([]int{1, 5, 10, 30}),
+											validation.IntDivisibleBy(60),
+										),
+									},
+									"stat": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.Any(
+											validation.StringInSlice(cloudwatch.Statistic_Values(), false),
+											validation.StringMatch(
+												// doesn't catch: PR with %-values provided, TM/WM/PR/TC/TS with no values provided
+												regexp.MustCompile(`^((p|(tm)|(wm)|(tc)|(ts))((\d{1,2}(\.\d{1,2})?)|(100))|(IQM)|(((TM)|(WM)|(PR)|(TC)|(TS)))\((\d+(\.\d+)?%?)?:(\d+(\.\d+)?%?)?\))$`),
+												"invalid statistic, see: https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/Statistics-definitions.html",
+											),
+										),
+									},
+									"unit": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(cloudwatch.StandardUnit_Values(), false),
+									},
+								},
+							},
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"period": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ValidateFunc: validation.Any(
+								validation.IntInSlice([]int{1, 5, 10, 30}),
+								validation.IntDivisibleBy(60),
+							),
+						},
+						"return_data": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"period": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ValidateFunc: validation.Any(
+					validation.IntInSlice([]int{1, 5, 10, 30}),
+					validation.IntDivisibleBy(60),
+				),
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"messages": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"status_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timestamps": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeFloat},
+						},
+					},
+				},
+			},
+			"scan_by": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      cloudwatch.ScanByTimestampAscending,
+				ValidateFunc: validation.StringInSlice(cloudwatch.ScanBy_Values(), false),
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidUTCTimestamp,
+			},
+		},
+	}
+}
+
+func dataSourceMetricDataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchConn(ctx)
+
+	startTime, _ := time.Parse(time.RFC3339, d.Get("start_time").(string))
+	endTime, _ := time.Parse(time.RFC3339, d.Get("end_time").(string))
+
+	if !endTime.After(startTime) {
+		return sdkdiag.AppendErrorf(diags, "end_time (%s) must be after start_time (%s)", d.Get("end_time").(string), d.Get("start_time").(string))
+	}
+
+	queries, err := expandMetricDataQueries(d.Get("metric_query").([]interface{}), d.Get("period").(int))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &cloudwatch.GetMetricDataInput{
+		EndTime:           aws.Time(endTime),
+		MetricDataQueries: queries,
+		ScanBy:            aws.String(d.Get("scan_by").(string)),
+		StartTime:         aws.Time(startTime),
+	}
+
+	if v, ok := d.GetOk("max_datapoints"); ok {
+		input.MaxDatapoints = aws.Int64(int64(v.(int)))
+	}
+
+	results, err := findMetricDataResults(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Metric Data: %s", err)
+	}
+
+	// Return the results in the order of the queries.
+	var ids []string
+	indexes := make(map[string]int)
+	for i, query := range queries {
+		id := aws.StringValue(query.Id)
+		ids = append(ids, id)
+		indexes[id] = i
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return indexes[aws.StringValue(results[i].Id)] < indexes[aws.StringValue(results[j].Id)]
+	})
+
+	d.SetId(strconv.Itoa(create.StringHashcode(strings.Join(append(ids, d.Get("start_time").(string), d.Get("end_time").(string)), ","))))
+	if err := d.Set("results", flattenMetricDataResults(results)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting results: %s", err)
+	}
+
+	return diags
+}
+
+// findMetricDataResults returns the results of the metric data queries with the datapoints of each query ID
+// from all pages merged into a single result.
+func findMetricDataResults(ctx context.Context, conn *cloudwatch.CloudWatch, input *cloudwatch.GetMetricDataInput) ([]*cloudwatch.MetricDataResult, error) {
+	var output []*cloudwatch.MetricDataResult
+	resultsByID := make(map[string]*cloudwatch.MetricDataResult)
+
+	err := conn.GetMetricDataPagesWithContext(ctx, input, func(page *cloudwatch.GetMetricDataOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MetricDataResults {
+			if v == nil {
+				continue
+			}
+
+			id := aws.StringValue(v.Id)
+			result, ok := resultsByID[id]
+
+			if !ok {
+				resultsByID[id] = v
+				output = append(output, v)
+				continue
+			}
+
+			result.Messages = append(result.Messages, v.Messages...)
+			result.StatusCode = v.StatusCode
+			result.Timestamps = append(result.Timestamps, v.Timestamps...)
+			result.Values = append(result.Values, v.Values...)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func expandMetricDataQueries(tfList []interface{}, period int) ([]*cloudwatch.MetricDataQuery, error) {
+	var apiObjects []*cloudwatch.MetricDataQuery
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		id := tfMap["id"].(string)
+		apiObject := &cloudwatch.MetricDataQuery{
+			Id:         aws.String(id),
+			ReturnData: aws.Bool(tfMap["return_data"].(bool)),
+		}
+
+		if v, ok := tfMap["account_id"].(string); ok && v != "" {
+			apiObject.AccountId = aws.String(v)
+		}
+
+		if v, ok := tfMap["label"].(string); ok && v != "" {
+			apiObject.Label = aws.String(v)
+		}
+
+		expression, _ := tfMap["expression"].(string)
+		metric, _ := tfMap["metric"].([]interface{})
+		queryPeriod, _ := tfMap["period"].(int)
+
+		switch {
+		case expression != "" && len(metric) > 0:
+			return nil, fmt.Errorf("metric_query (%s): only one of expression or metric can be specified", id)
+		case expression != "":
+			apiObject.Expression = aws.String(expression)
+
+			// The period of the expression defaults to the period of the data source.
+			if queryPeriod != 0 {
+				apiObject.Period = aws.Int64(int64(queryPeriod))
+			} else if period != 0 {
+				apiObject.Period = aws.Int64(int64(period))
+			}
+		case len(metric) > 0 && metric[0] != nil:
+			if queryPeriod != 0 {
+				return nil, fmt.Errorf("metric_query (%s): period can only be specified with expression, use metric.period instead", id)
+			}
+
+			metricStat := expandMetricAlarmMetricsMetric(metric)
+
+			// The period of the metric defaults to the period of the data source.
+			if aws.Int64Value(metricStat.Period) == 0 {
+				if period == 0 {
+					return nil, fmt.Errorf("metric_query (%s): one of metric.period or period must be specified", id)
+				}
+
+				metricStat.Period = aws.Int64(int64(period))
+			}
+
+			apiObject.MetricStat = metricStat
+		default:
+			return nil, fmt.Errorf("metric_query (%s): one of expression or metric must be specified", id)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+func flattenMetricDataResults(apiObjects []*cloudwatch.MetricDataResult) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		var messages []interface{}
+		for _, v := range apiObject.Messages {
+			if v == nil {
+				continue
+			}
+
+			messages = append(messages, fmt.Sprintf("%s: %s", aws.StringValue(v.Code), aws.StringValue(v.Value)))
+		}
+
+		var timestamps []interface{}
+		for _, v := range apiObject.Timestamps {
+			timestamps = append(timestamps, aws.TimeValue(v).Format(time.RFC3339))
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"id":          aws.StringValue(apiObject.Id),
+			"label":       aws.StringValue(apiObject.Label),
+			"messages":    messages,
+			"status_code": aws.StringValue(apiObject.StatusCode),
+			"timestamps":  timestamps,
+			"values":      aws.Float64ValueSlice(apiObject.Values),
+		})
+	}
+
+	return tfList
+}
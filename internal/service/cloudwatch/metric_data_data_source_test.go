@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudWatchMetricDataDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_metric_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricDataDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.id", "calls"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.status_code", "Complete"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.id", "constant"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.label", "Constant"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.status_code", "Complete"),
+					resource.TestMatchResourceAttr(dataSourceName, "results.1.timestamps.#", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.values.0", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchMetricDataDataSource_invalidQuery(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMetricDataDataSourceConfig_noPeriod,
+				ExpectError: regexp.MustCompile(`one of metric.period or period must be specified`),
+			},
+			{
+				Config:      testAccMetricDataDataSourceConfig_periodWithMetric,
+				ExpectError: regexp.MustCompile(`period can only be specified with expression`),
+			},
+		},
+	})
+}
+
+const testAccMetricDataDataSourceConfig_basic = `
+data "aws_cloudwatch_metric_data" "test" {
+  start_time = timeadd(timestamp(), "-3h")
+  end_time   = timestamp()
+  period     = 300
+
+  metric_query {
+    id = "calls"
+
+    metric {
+      metric_name = "CallCount"
+      namespace   = "AWS/Usage"
+      stat        = "Sum"
+
+      dimensions = {
+        Class    = "None"
+        Resource = "GetMetricData"
+        Service  = "CloudWatch"
+        Type     = "API"
+      }
+    }
+  }
+
+  metric_query {
+    id         = "constant"
+    expression = "TIME_SERIES(1)"
+    label      = "Constant"
+  }
+}
+`
+
+const testAccMetricDataDataSourceConfig_noPeriod = `
+data "aws_cloudwatch_metric_data" "test" {
+  start_time = timeadd(timestamp(), "-1h")
+  end_time   = timestamp()
+
+  metric_query {
+    id = "cpu"
+
+    metric {
+      metric_name = "CPUUtilization"
+      namespace   = "AWS/EC2"
+      stat        = "Average"
+    }
+  }
+}
+`
+
+const testAccMetricDataDataSourceConfig_periodWithMetric = `
+data "aws_cloudwatch_metric_data" "test" {
+  start_time = timeadd(timestamp(), "-1h")
+  end_time   = timestamp()
+
+  metric_query {
+    id     = "cpu"
+    period = 300
+
+    metric {
+      metric_name = "CPUUtilization"
+      namespace   = "AWS/EC2"
+      stat        = "Average"
+    }
+  }
+}
+`
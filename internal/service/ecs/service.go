@@ -321,6 +321,11 @@ func ResourceService() *schema.Resource {
 				},
 				ValidateFunc: validation.StringInSlice(ecs.PropagateTags_Values(), false),
 			},
+			"rollback_on_deployment_failure": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"scheduling_strategy": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -621,6 +626,8 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 		fn = waitServiceStable
 	}
 	if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		err = rollbackServiceDeployment(ctx, conn, d, err, d.Timeout(schema.TimeoutCreate))
+
 		return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) create: %s", d.Id(), err)
 	}
 
@@ -781,7 +788,7 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECSConn(ctx)
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChangesExcept("rollback_on_deployment_failure", "tags", "tags_all") {
 		input := &ecs.UpdateServiceInput{
 			Cluster:            aws.String(d.Get("cluster").(string)),
 			ForceNewDeployment: aws.Bool(d.Get("force_new_deployment").(bool)),
@@ -944,6 +951,8 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			fn = waitServiceStable
 		}
 		if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			err = rollbackServiceDeployment(ctx, conn, d, err, d.Timeout(schema.TimeoutUpdate))
+
 			return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) update: %s", d.Id(), err)
 		}
 	}
@@ -1020,6 +1029,54 @@ func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta int
 	return diags
 }
 
+// rollbackServiceDeployment updates an ECS Service to its previous task definition if waiting for the service to
+// become stable failed because its deployment failed, "rollback_on_deployment_failure" is set and ECS is not already
+// rolling back the deployment. It waits for the rollback deployment to complete, records the task definition that is
+// running in state and returns the original error with the result of the rollback.
+func rollbackServiceDeployment(ctx context.Context, conn *ecs.ECS, d *schema.ResourceData, err error, timeout time.Duration) error {
+	var failed *serviceDeploymentFailedError
+	if !d.Get("rollback_on_deployment_failure").(bool) || !errors.As(err, &failed) || failed.RollbackEnabled {
+		return err
+	}
+
+	taskDefinition := failed.PreviousTaskDefinition
+	if o, n := d.GetChange("task_definition"); taskDefinition == "" && o.(string) != n.(string) {
+		taskDefinition = o.(string)
+	}
+
+	if taskDefinition == "" {
+		failed.Rollback = "there is no previous task definition to roll back to"
+		return failed
+	}
+
+	cluster := d.Get("cluster").(string)
+	input := &ecs.UpdateServiceInput{
+		Cluster:        aws.String(cluster),
+		Service:        aws.String(d.Id()),
+		TaskDefinition: aws.String(taskDefinition),
+	}
+
+	if _, err := conn.UpdateServiceWithContext(ctx, input); err != nil {
+		failed.Rollback = fmt.Sprintf("rolling back to task definition (%s): %s", taskDefinition, err)
+		return failed
+	}
+
+	service, err := waitServiceStable(ctx, conn, d.Id(), cluster, timeout)
+
+	if service != nil {
+		d.Set("task_definition", service.TaskDefinition)
+	}
+
+	if err != nil {
+		failed.Rollback = fmt.Sprintf("waiting for rollback to task definition (%s): %s", taskDefinition, err)
+		return failed
+	}
+
+	failed.Rollback = fmt.Sprintf("rolled back to task definition (%s)", aws.StringValue(service.TaskDefinition))
+
+	return failed
+}
+
 func resourceServiceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	if len(strings.Split(d.Id(), "/")) != 2 {
 		return []*schema.ResourceData{}, fmt.Errorf("wrong format of resource: %s, expecting 'cluster-name/service-name'", d.Id())
@@ -0,0 +1,357 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	deploymentStatusActive  = "ACTIVE"
+	deploymentStatusPrimary = "PRIMARY"
+
+	// Maximum number of stopped tasks and service events included in a deployment failure summary.
+	serviceDeploymentSummaryMaxStoppedTasks = 5
+	serviceDeploymentSummaryMaxEvents       = 5
+)
+
+// serviceDeploymentProgress tracks the deployment that is primary when waiting for an ECS Service to become stable
+// and streams its progress, the service's events and any triggered deployment alarms to the log.
+type serviceDeploymentProgress struct {
+	serviceID       string
+	deploymentID    string
+	startedAt       time.Time
+	lastProgress    string
+	events          []*ecs.ServiceEvent
+	seenEvents      map[string]struct{}
+	triggeredAlarms []string
+	service         *ecs.Service
+}
+
+func newServiceDeploymentProgress(serviceID string) *serviceDeploymentProgress {
+	return &serviceDeploymentProgress{
+		serviceID:  serviceID,
+		startedAt:  time.Now(),
+		seenEvents: make(map[string]struct{}),
+	}
+}
+
+// log records the current state of the service and logs anything that has changed since the last call.
+func (p *serviceDeploymentProgress) log(ctx context.Context, service *ecs.Service) {
+	p.service = service
+
+	// A deployment that is no longer one of the service's deployments has been superseded,
+	// e.g. by a rollback or another update, so track the new primary deployment instead.
+	if deployment := primaryDeployment(service); deployment != nil && p.deployment(service) == nil {
+		id := aws.StringValue(deployment.Id)
+
+		if p.deploymentID != "" {
+			tflog.Info(ctx, "ECS Service deployment superseded", map[string]any{
+				"service":               p.serviceID,
+				"deployment_id":         p.deploymentID,
+				"primary_deployment_id": id,
+			})
+		}
+
+		p.deploymentID = id
+		p.lastProgress = ""
+	}
+
+	if deployment := p.deployment(service); deployment != nil {
+		fields := map[string]any{
+			"service":        p.serviceID,
+			"deployment_id":  p.deploymentID,
+			"rollout_state":  aws.StringValue(deployment.RolloutState),
+			"desired_count":  aws.Int64Value(deployment.DesiredCount),
+			"running_count":  aws.Int64Value(deployment.RunningCount),
+			"pending_count":  aws.Int64Value(deployment.PendingCount),
+			"failed_tasks":   aws.Int64Value(deployment.FailedTasks),
+			"rollout_reason": aws.StringValue(deployment.RolloutStateReason),
+		}
+
+		if progress := fmt.Sprint(fields); progress != p.lastProgress {
+			p.lastProgress = progress
+			tflog.Info(ctx, "ECS Service deployment progress", fields)
+		}
+	}
+
+	// Events are returned newest first.
+	for i := len(service.Events) - 1; i >= 0; i-- {
+		event := service.Events[i]
+		id := aws.StringValue(event.Id)
+
+		if _, ok := p.seenEvents[id]; ok {
+			continue
+		}
+		p.seenEvents[id] = struct{}{}
+
+		if aws.TimeValue(event.CreatedAt).Before(p.startedAt.Add(-time.Minute)) {
+			continue
+		}
+
+		p.events = append(p.events, event)
+		tflog.Info(ctx, "ECS Service event", map[string]any{
+			"service":    p.serviceID,
+			"created_at": aws.TimeValue(event.CreatedAt).Format(time.RFC3339),
+			"message":    aws.StringValue(event.Message),
+		})
+	}
+
+	for _, name := range p.alarmsInState(service) {
+		if !slices.Contains(p.triggeredAlarms, name) {
+			p.triggeredAlarms = append(p.triggeredAlarms, name)
+			tflog.Warn(ctx, "ECS Service deployment alarm triggered", map[string]any{
+				"service":       p.serviceID,
+				"deployment_id": p.deploymentID,
+				"alarm_name":    name,
+			})
+		}
+	}
+}
+
+// deployment returns the tracked deployment, or nil if it is no longer one of the service's deployments.
+func (p *serviceDeploymentProgress) deployment(service *ecs.Service) *ecs.Deployment {
+	for _, v := range service.Deployments {
+		if aws.StringValue(v.Id) == p.deploymentID {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// failed returns whether the tracked deployment's rollout state is FAILED.
+// Services that use the CODE_DEPLOY or EXTERNAL deployment controllers have no deployment to track.
+func (p *serviceDeploymentProgress) failed(service *ecs.Service) bool {
+	deployment := p.deployment(service)
+
+	return deployment != nil && aws.StringValue(deployment.RolloutState) == ecs.DeploymentRolloutStateFailed
+}
+
+// alarmsInState returns the names of the service's deployment alarms that are mentioned by the tracked deployment's
+// rollout state reason or by service events since waiting started.
+// DescribeServices does not return alarm states, but ECS reports the alarms that it detected in ALARM state.
+func (p *serviceDeploymentProgress) alarmsInState(service *ecs.Service) []string {
+	if service.DeploymentConfiguration == nil || service.DeploymentConfiguration.Alarms == nil || !aws.BoolValue(service.DeploymentConfiguration.Alarms.Enable) {
+		return nil
+	}
+
+	messages := make([]string, 0, len(p.events)+1)
+	if deployment := p.deployment(service); deployment != nil {
+		messages = append(messages, aws.StringValue(deployment.RolloutStateReason))
+	}
+	for _, v := range p.events {
+		messages = append(messages, aws.StringValue(v.Message))
+	}
+
+	var names []string
+	for _, name := range aws.StringValueSlice(service.DeploymentConfiguration.Alarms.AlarmNames) {
+		for _, message := range messages {
+			if strings.Contains(message, name) {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+
+	return names
+}
+
+// summary returns a description of the tracked deployment's last known state.
+func (p *serviceDeploymentProgress) summary() string {
+	if p.service == nil {
+		return ""
+	}
+
+	deployment := p.deployment(p.service)
+	if deployment == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("ECS Deployment (%s) rollout state %s, %d of %d tasks running, %d pending, %d failed",
+		p.deploymentID,
+		aws.StringValue(deployment.RolloutState),
+		aws.Int64Value(deployment.RunningCount),
+		aws.Int64Value(deployment.DesiredCount),
+		aws.Int64Value(deployment.PendingCount),
+		aws.Int64Value(deployment.FailedTasks))
+}
+
+// failure returns an error describing why the tracked deployment failed.
+func (p *serviceDeploymentProgress) failure(ctx context.Context, conn *ecs.ECS, cluster string) *serviceDeploymentFailedError {
+	err := &serviceDeploymentFailedError{
+		DeploymentID:    p.deploymentID,
+		TriggeredAlarms: p.triggeredAlarms,
+	}
+
+	if config := p.service.DeploymentConfiguration; config != nil {
+		err.RollbackEnabled = (config.DeploymentCircuitBreaker != nil && aws.BoolValue(config.DeploymentCircuitBreaker.Rollback)) ||
+			(config.Alarms != nil && aws.BoolValue(config.Alarms.Enable) && aws.BoolValue(config.Alarms.Rollback))
+	}
+
+	for _, v := range p.service.Deployments {
+		if aws.StringValue(v.Id) != p.deploymentID && aws.StringValue(v.Status) == deploymentStatusActive {
+			err.PreviousTaskDefinition = aws.StringValue(v.TaskDefinition)
+			break
+		}
+	}
+
+	if deployment := p.deployment(p.service); deployment != nil {
+		err.Reason = aws.StringValue(deployment.RolloutStateReason)
+		err.FailedTasks = aws.Int64Value(deployment.FailedTasks)
+	}
+
+	if err.FailedTasks > 0 {
+		stoppedTasks, findErr := findStoppedTaskReasons(ctx, conn, cluster, p.deploymentID, serviceDeploymentSummaryMaxStoppedTasks)
+
+		if findErr != nil {
+			tflog.Warn(ctx, "reading ECS Deployment stopped tasks", map[string]any{
+				"deployment_id": p.deploymentID,
+				"error":         findErr.Error(),
+			})
+		}
+
+		err.StoppedTasks = stoppedTasks
+	}
+
+	events := p.events
+	if n := len(events); n > serviceDeploymentSummaryMaxEvents {
+		events = events[n-serviceDeploymentSummaryMaxEvents:]
+	}
+	for _, v := range events {
+		err.Events = append(err.Events, aws.StringValue(v.Message))
+	}
+
+	return err
+}
+
+// serviceDeploymentFailedError is returned when the deployment being waited on fails.
+type serviceDeploymentFailedError struct {
+	DeploymentID           string
+	Events                 []string
+	FailedTasks            int64
+	PreviousTaskDefinition string
+	Reason                 string
+	Rollback               string
+	RollbackEnabled        bool
+	StoppedTasks           []string
+	TriggeredAlarms        []string
+}
+
+func (e *serviceDeploymentFailedError) Error() string {
+	var b strings.Builder
+
+	if e.Reason != "" {
+		fmt.Fprintf(&b, "ECS Deployment (%s) failed: %s", e.DeploymentID, e.Reason)
+	} else {
+		fmt.Fprintf(&b, "ECS Deployment (%s) failed", e.DeploymentID)
+	}
+
+	if e.Rollback != "" {
+		fmt.Fprintf(&b, "\n\nrollback: %s", e.Rollback)
+	}
+
+	if e.FailedTasks > 0 {
+		fmt.Fprintf(&b, "\n\nfailed tasks: %d", e.FailedTasks)
+	}
+
+	if len(e.TriggeredAlarms) > 0 {
+		fmt.Fprintf(&b, "\n\ntriggered alarms: %s", strings.Join(e.TriggeredAlarms, ", "))
+	}
+
+	if len(e.StoppedTasks) > 0 {
+		b.WriteString("\n\nstopped tasks:")
+		for _, v := range e.StoppedTasks {
+			fmt.Fprintf(&b, "\n  %s", v)
+		}
+	}
+
+	if len(e.Events) > 0 {
+		b.WriteString("\n\nservice events:")
+		for _, v := range e.Events {
+			fmt.Fprintf(&b, "\n  %s", v)
+		}
+	}
+
+	return b.String()
+}
+
+// findStoppedTaskReasons returns the reasons that up to maxTasks tasks started by the specified deployment stopped.
+func findStoppedTaskReasons(ctx context.Context, conn *ecs.ECS, cluster, deploymentID string, maxTasks int) ([]string, error) {
+	input := &ecs.ListTasksInput{
+		DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+		MaxResults:    aws.Int64(int64(maxTasks)),
+		StartedBy:     aws.String(deploymentID),
+	}
+
+	if cluster != "" {
+		input.Cluster = aws.String(cluster)
+	}
+
+	listOutput, err := conn.ListTasksWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(listOutput.TaskArns) == 0 {
+		return nil, nil
+	}
+
+	describeInput := &ecs.DescribeTasksInput{
+		Tasks: listOutput.TaskArns,
+	}
+
+	if cluster != "" {
+		describeInput.Cluster = aws.String(cluster)
+	}
+
+	describeOutput, err := conn.DescribeTasksWithContext(ctx, describeInput)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var reasons []string
+
+	for _, task := range describeOutput.Tasks {
+		reason := aws.StringValue(task.StoppedReason)
+
+		var containerReasons []string
+		for _, container := range task.Containers {
+			switch {
+			case aws.StringValue(container.Reason) != "":
+				containerReasons = append(containerReasons, fmt.Sprintf("%s: %s", aws.StringValue(container.Name), aws.StringValue(container.Reason)))
+			case container.ExitCode != nil && aws.Int64Value(container.ExitCode) != 0:
+				containerReasons = append(containerReasons, fmt.Sprintf("%s: exit code %d", aws.StringValue(container.Name), aws.Int64Value(container.ExitCode)))
+			}
+		}
+
+		if len(containerReasons) > 0 {
+			reason = fmt.Sprintf("%s (%s)", reason, strings.Join(containerReasons, "; "))
+		}
+
+		reasons = append(reasons, fmt.Sprintf("%s: %s", aws.StringValue(task.TaskArn), reason))
+	}
+
+	return reasons, nil
+}
+
+func primaryDeployment(service *ecs.Service) *ecs.Deployment {
+	for _, v := range service.Deployments {
+		if aws.StringValue(v.Status) == deploymentStatusPrimary {
+			return v
+		}
+	}
+
+	return nil
+}
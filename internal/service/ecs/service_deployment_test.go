@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestServiceDeploymentProgress(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Now()
+
+	service := &ecs.Service{
+		DeploymentConfiguration: &ecs.DeploymentConfiguration{
+			Alarms: &ecs.DeploymentAlarms{
+				AlarmNames: aws.StringSlice([]string{"cpu-high", "errors-high"}),
+				Enable:     aws.Bool(true),
+				Rollback:   aws.Bool(false),
+			},
+		},
+		Deployments: []*ecs.Deployment{
+			{
+				Id:           aws.String("ecs-svc/2"),
+				RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress),
+				Status:       aws.String(deploymentStatusPrimary),
+			},
+			{
+				Id:             aws.String("ecs-svc/1"),
+				RolloutState:   aws.String(ecs.DeploymentRolloutStateCompleted),
+				Status:         aws.String(deploymentStatusActive),
+				TaskDefinition: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/test:1"),
+			},
+		},
+		Events: []*ecs.ServiceEvent{
+			{
+				CreatedAt: aws.Time(now.Add(-time.Hour)),
+				Id:        aws.String("1"),
+				Message:   aws.String("(service test) has reached a steady state."),
+			},
+		},
+	}
+
+	progress := newServiceDeploymentProgress("test")
+	progress.log(ctx, service)
+
+	if got, want := progress.deploymentID, "ecs-svc/2"; got != want {
+		t.Errorf("deploymentID = %q, want %q", got, want)
+	}
+	if progress.failed(service) {
+		t.Error("failed = true, want false")
+	}
+	if got := len(progress.events); got != 0 {
+		t.Errorf("len(events) = %d, want 0", got)
+	}
+
+	service.Deployments[0].RolloutState = aws.String(ecs.DeploymentRolloutStateFailed)
+	service.Deployments[0].RolloutStateReason = aws.String("Alarm detected.")
+	service.Events = append([]*ecs.ServiceEvent{
+		{
+			CreatedAt: aws.Time(now),
+			Id:        aws.String("2"),
+			Message:   aws.String("(service test) deployment ecs-svc/2 detected alarm cpu-high in ALARM state."),
+		},
+	}, service.Events...)
+	progress.log(ctx, service)
+
+	if !progress.failed(service) {
+		t.Error("failed = false, want true")
+	}
+
+	got := progress.failure(ctx, nil, "")
+	want := &serviceDeploymentFailedError{
+		DeploymentID:           "ecs-svc/2",
+		Events:                 []string{"(service test) deployment ecs-svc/2 detected alarm cpu-high in ALARM state."},
+		PreviousTaskDefinition: "arn:aws:ecs:us-west-2:123456789012:task-definition/test:1",
+		Reason:                 "Alarm detected.",
+		TriggeredAlarms:        []string{"cpu-high"},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+
+	// The deployment is superseded by a rollback, which is tracked instead.
+	service.Deployments = []*ecs.Deployment{
+		{
+			Id:           aws.String("ecs-svc/3"),
+			RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress),
+			Status:       aws.String(deploymentStatusPrimary),
+		},
+	}
+	progress.log(ctx, service)
+
+	if got, want := progress.deploymentID, "ecs-svc/3"; got != want {
+		t.Errorf("deploymentID after rollback = %q, want %q", got, want)
+	}
+	if progress.failed(service) {
+		t.Error("failed = true after rollback, want false")
+	}
+
+	service.Deployments[0].RolloutState = aws.String(ecs.DeploymentRolloutStateFailed)
+
+	if !progress.failed(service) {
+		t.Error("failed = false after rollback failed, want true")
+	}
+}
+
+func TestServiceDeploymentProgress_superseded(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	service := &ecs.Service{
+		Deployments: []*ecs.Deployment{
+			{
+				Id:           aws.String("ecs-svc/1"),
+				RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress),
+				Status:       aws.String(deploymentStatusPrimary),
+			},
+		},
+	}
+
+	progress := newServiceDeploymentProgress("test")
+	progress.log(ctx, service)
+
+	// The tracked deployment is gone before another deployment becomes primary.
+	service.Deployments = nil
+
+	if progress.failed(service) {
+		t.Error("failed = true without deployments, want false")
+	}
+
+	progress.log(ctx, service)
+
+	if got, want := progress.deploymentID, "ecs-svc/1"; got != want {
+		t.Errorf("deploymentID = %q, want %q", got, want)
+	}
+}
+
+func TestServiceDeploymentProgress_noDeployments(t *testing.T) {
+	t.Parallel()
+
+	service := &ecs.Service{}
+
+	progress := newServiceDeploymentProgress("test")
+	progress.log(context.Background(), service)
+
+	if progress.failed(service) {
+		t.Error("failed = true, want false")
+	}
+	if got := progress.summary(); got != "" {
+		t.Errorf("summary = %q, want empty", got)
+	}
+}
+
+func TestServiceDeploymentFailedError(t *testing.T) {
+	t.Parallel()
+
+	err := &serviceDeploymentFailedError{
+		DeploymentID:    "ecs-svc/2",
+		Events:          []string{"(service test) deployment ecs-svc/2 deployment failed: tasks failed to start."},
+		FailedTasks:     3,
+		Reason:          "ECS deployment circuit breaker: tasks failed to start.",
+		StoppedTasks:    []string{"arn:aws:ecs:us-west-2:123456789012:task/test/abc: Essential container in task exited (web: exit code 1)"},
+		TriggeredAlarms: []string{"cpu-high"},
+	}
+
+	want := `ECS Deployment (ecs-svc/2) failed: ECS deployment circuit breaker: tasks failed to start.
+
+failed tasks: 3
+
+triggered alarms: cpu-high
+
+stopped tasks:
+  arn:aws:ecs:us-west-2:123456789012:task/test/abc: Essential container in task exited (web: exit code 1)
+
+service events:
+  (service test) deployment ecs-svc/2 deployment failed: tasks failed to start.`
+
+	if got := err.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	err = &serviceDeploymentFailedError{
+		DeploymentID: "ecs-svc/2",
+	}
+
+	if got, want := err.Error(), "ECS Deployment (ecs-svc/2) failed"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	err = &serviceDeploymentFailedError{
+		DeploymentID: "ecs-svc/2",
+		Reason:       "ECS deployment circuit breaker: tasks failed to start.",
+		Rollback:     "rolled back to task definition (arn:aws:ecs:us-west-2:123456789012:task-definition/test:1)",
+	}
+
+	want = `ECS Deployment (ecs-svc/2) failed: ECS deployment circuit breaker: tasks failed to start.
+
+rollback: rolled back to task definition (arn:aws:ecs:us-west-2:123456789012:task-definition/test:1)`
+
+	if got := err.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
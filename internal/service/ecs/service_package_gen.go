@@ -41,6 +41,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceTaskDefinition,
 			TypeName: "aws_ecs_task_definition",
 		},
+		{
+			Factory:  DataSourceTaskDefinitionDocument,
+			TypeName: "aws_ecs_task_definition_document",
+		},
 		{
 			Factory:  DataSourceTaskExecution,
 			TypeName: "aws_ecs_task_execution",
@@ -147,8 +147,8 @@ func TestAccECSService_basicImport(t *testing.T) {
 				ImportStateId:     importInput,
 				ImportState:       true,
 				ImportStateVerify: true,
-				// wait_for_steady_state is not read from API
-				ImportStateVerifyIgnore: []string{"wait_for_steady_state"},
+				// rollback_on_deployment_failure and wait_for_steady_state are not read from API
+				ImportStateVerifyIgnore: []string{"rollback_on_deployment_failure", "wait_for_steady_state"},
 			},
 			// Test non-existent resource import
 			{
@@ -432,8 +432,8 @@ func TestAccECSService_DeploymentControllerType_codeDeploy(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				// Resource currently defaults to importing task_definition as family:revision
-				// and wait_for_steady_state is not read from API
-				ImportStateVerifyIgnore: []string{"task_definition", "wait_for_steady_state"},
+				// and rollback_on_deployment_failure and wait_for_steady_state are not read from API
+				ImportStateVerifyIgnore: []string{"rollback_on_deployment_failure", "task_definition", "wait_for_steady_state"},
 			},
 		},
 	})
@@ -507,8 +507,8 @@ func TestAccECSService_DeploymentControllerType_external(t *testing.T) {
 				ImportStateId:     fmt.Sprintf("%s/%s", rName, rName),
 				ImportState:       true,
 				ImportStateVerify: true,
-				// wait_for_steady_state is not read from API
-				ImportStateVerifyIgnore: []string{"wait_for_steady_state"},
+				// rollback_on_deployment_failure and wait_for_steady_state are not read from API
+				ImportStateVerifyIgnore: []string{"rollback_on_deployment_failure", "wait_for_steady_state"},
 			},
 		},
 	})
@@ -1054,8 +1054,47 @@ func TestAccECSService_LaunchTypeFargate_waitForSteadyState(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				// Resource currently defaults to importing task_definition as family:revision
-				// and wait_for_steady_state is not read from API
-				ImportStateVerifyIgnore: []string{"task_definition", "wait_for_steady_state"},
+				// and rollback_on_deployment_failure and wait_for_steady_state are not read from API
+				ImportStateVerifyIgnore: []string{"rollback_on_deployment_failure", "task_definition", "wait_for_steady_state"},
+			},
+		},
+	})
+}
+
+func TestAccECSService_LaunchTypeFargate_rollbackOnDeploymentFailure(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service ecs.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_launchTypeFargateRollbackOnDeploymentFailure(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "rollback_on_deployment_failure", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "task_definition", "aws_ecs_task_definition.test", "arn"),
+				),
+			},
+			{
+				Config:      testAccServiceConfig_launchTypeFargateRollbackOnDeploymentFailure(rName, "invalid"),
+				ExpectError: regexp.MustCompile(`ECS Deployment \(.+\) failed[\s\S]*rollback: rolled back to task definition`),
+			},
+			{
+				Config: testAccServiceConfig_launchTypeFargateRollbackOnDeploymentFailure(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttrPair(resourceName, "task_definition", "aws_ecs_task_definition.test", "arn"),
+				),
 			},
 		},
 	})
@@ -1459,8 +1498,8 @@ func TestAccECSService_Tags_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				// Resource currently defaults to importing task_definition as family:revision
-				// and wait_for_steady_state is not read from API
-				ImportStateVerifyIgnore: []string{"task_definition", "wait_for_steady_state"},
+				// and rollback_on_deployment_failure and wait_for_steady_state are not read from API
+				ImportStateVerifyIgnore: []string{"rollback_on_deployment_failure", "task_definition", "wait_for_steady_state"},
 			},
 			{
 				Config: testAccServiceConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
@@ -1865,6 +1904,53 @@ resource "aws_ecs_service" "test" {
 `, rName, desiredCount, waitForSteadyState))
 }
 
+func testAccServiceConfig_launchTypeFargateRollbackOnDeploymentFailure(rName, taskDefinition string) string {
+	return acctest.ConfigCompose(testAccServiceConfig_launchTypeFargateBase(rName), fmt.Sprintf(`
+resource "aws_ecs_task_definition" "invalid" {
+  family                   = "%[1]s-invalid"
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 256,
+    "essential": true,
+    "image": "public.ecr.aws/%[1]s/does-not-exist:latest",
+    "memory": 512,
+    "name": "invalid",
+    "networkMode": "awsvpc"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.%[2]s.arn
+  desired_count   = 1
+  launch_type     = "FARGATE"
+
+  network_configuration {
+    security_groups  = [aws_security_group.test[0].id]
+    subnets          = aws_subnet.test[*].id
+    assign_public_ip = true
+  }
+
+  deployment_circuit_breaker {
+    enable   = true
+    rollback = false
+  }
+
+  rollback_on_deployment_failure = true
+  wait_for_steady_state          = true
+}
+`, rName, taskDefinition))
+}
+
 func testAccServiceConfig_interchangeablePlacementStrategy(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
@@ -19,6 +19,7 @@ const (
 	// Non-standard statuses for statusServiceWaitForStable()
 	serviceStatusPending = "tfPENDING"
 	serviceStatusStable  = "tfSTABLE"
+	serviceStatusFailed  = "tfFAILED"
 
 	taskSetStatusActive   = "ACTIVE"
 	taskSetStatusDraining = "DRAINING"
@@ -71,7 +72,7 @@ func statusServiceNoTags(ctx context.Context, conn *ecs.ECS, id, cluster string)
 	}
 }
 
-func statusServiceWaitForStable(ctx context.Context, conn *ecs.ECS, id, cluster string) retry.StateRefreshFunc {
+func statusServiceWaitForStable(ctx context.Context, conn *ecs.ECS, id, cluster string, progress *serviceDeploymentProgress) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		serviceRaw, status, err := statusServiceNoTags(ctx, conn, id, cluster)()
 		if err != nil {
@@ -84,6 +85,12 @@ func statusServiceWaitForStable(ctx context.Context, conn *ecs.ECS, id, cluster
 
 		service := serviceRaw.(*ecs.Service)
 
+		progress.log(ctx, service)
+
+		if progress.failed(service) {
+			return service, serviceStatusFailed, nil
+		}
+
 		if d, dc, rc := len(service.Deployments),
 			aws.Int64Value(service.DesiredCount),
 			aws.Int64Value(service.RunningCount); d == 1 && dc == rc {
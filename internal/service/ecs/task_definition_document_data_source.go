@@ -0,0 +1,510 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// @SDKDataSource("aws_ecs_task_definition_document")
+func DataSourceTaskDefinitionDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTaskDefinitionDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"container_definitions": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cpu": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ephemeral_storage": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"size_in_gib": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"execution_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"family": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inference_accelerator": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ipc_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"memory": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pid_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"placement_constraints": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expression": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"proxy_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"properties": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"requires_compatibilities": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"runtime_platform": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpu_architecture": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operating_system_family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"source_json": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"task_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"docker_volume_configuration": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"autoprovision": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"driver": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"driver_opts": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"labels": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"scope": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"efs_volume_configuration": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"authorization_config": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"access_point_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"iam": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"file_system_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"root_directory": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"transit_encryption": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"transit_encryption_port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"fsx_windows_file_server_volume_configuration": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"authorization_config": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"credentials_parameter": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"domain": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"file_system_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"root_directory": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"host_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTaskDefinitionDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input, err := expandTaskDefinitionDocument(d.Get("source_json").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECS Task Definition Document: %s", err)
+	}
+
+	// Sort the lists of environment variables so that the output is stable.
+	containerDefinitions(input.ContainerDefinitions).OrderEnvironmentVariables()
+
+	defs, err := flattenContainerDefinitions(input.ContainerDefinitions)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECS Task Definition Document: %s", err)
+	}
+
+	defs, err = structure.NormalizeJsonString(defs)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECS Task Definition Document: %s", err)
+	}
+
+	document, err := flattenTaskDefinitionDocument(input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECS Task Definition Document: %s", err)
+	}
+
+	d.SetId(aws.StringValue(input.Family))
+	d.Set("container_definitions", defs)
+	d.Set("cpu", input.Cpu)
+	if err := d.Set("ephemeral_storage", flattenTaskDefinitionEphemeralStorage(input.EphemeralStorage)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ephemeral_storage: %s", err)
+	}
+	d.Set("execution_role_arn", input.ExecutionRoleArn)
+	d.Set("family", input.Family)
+	if err := d.Set("inference_accelerator", flattenInferenceAccelerators(input.InferenceAccelerators)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting inference_accelerator: %s", err)
+	}
+	d.Set("ipc_mode", input.IpcMode)
+	d.Set("json", document)
+	d.Set("memory", input.Memory)
+	d.Set("network_mode", input.NetworkMode)
+	d.Set("pid_mode", input.PidMode)
+	if err := d.Set("placement_constraints", flattenPlacementConstraints(input.PlacementConstraints)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting placement_constraints: %s", err)
+	}
+	if err := d.Set("proxy_configuration", flattenProxyConfiguration(input.ProxyConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting proxy_configuration: %s", err)
+	}
+	if err := d.Set("requires_compatibilities", flex.FlattenStringList(input.RequiresCompatibilities)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting requires_compatibilities: %s", err)
+	}
+	if err := d.Set("runtime_platform", flattenRuntimePlatform(input.RuntimePlatform)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting runtime_platform: %s", err)
+	}
+	if err := d.Set("tags", KeyValueTags(ctx, input.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+	d.Set("task_role_arn", input.TaskRoleArn)
+	if err := d.Set("volume", flattenVolumes(input.Volumes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting volume: %s", err)
+	}
+
+	return diags
+}
+
+// taskDefinitionReadOnlyFields are the fields returned by DescribeTaskDefinition
+// that cannot be passed to RegisterTaskDefinition.
+var taskDefinitionReadOnlyFields = []string{
+	"compatibilities",
+	"deregisteredAt",
+	"registeredAt",
+	"registeredBy",
+	"requiresAttributes",
+	"revision",
+	"status",
+	"taskDefinitionArn",
+}
+
+// expandTaskDefinitionDocument parses a RegisterTaskDefinition request document.
+// The output of DescribeTaskDefinition, with or without the enclosing "taskDefinition"
+// object, is also accepted. Read-only fields are discarded, any other unknown
+// top-level field is an error.
+func expandTaskDefinitionDocument(document string) (*ecs.RegisterTaskDefinitionInput, error) {
+	var fields map[string]json.RawMessage
+
+	if err := json.Unmarshal([]byte(document), &fields); err != nil {
+		return nil, fmt.Errorf("decoding JSON: %w", err)
+	}
+
+	if v, ok := fields["taskDefinition"]; ok {
+		var taskDefinition map[string]json.RawMessage
+
+		if err := json.Unmarshal(v, &taskDefinition); err != nil {
+			return nil, fmt.Errorf("decoding JSON: taskDefinition: %w", err)
+		}
+
+		// DescribeTaskDefinition returns tags alongside the task definition.
+		if v, ok := fields["tags"]; ok {
+			taskDefinition["tags"] = v
+		}
+
+		fields = taskDefinition
+	}
+
+	for _, v := range taskDefinitionReadOnlyFields {
+		delete(fields, v)
+	}
+
+	b, err := json.Marshal(fields)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &ecs.RegisterTaskDefinitionInput{}
+
+	if err := jsonutil.UnmarshalJSON(input, bytes.NewReader(b)); err != nil {
+		return nil, fmt.Errorf("decoding JSON: %w", err)
+	}
+
+	// The SDK decoder ignores unknown fields, so compare the document with the decoded request.
+	unknown, err := taskDefinitionDocumentUnknownFields(b, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("unsupported task definition fields: %s", strings.Join(unknown, ", "))
+	}
+
+	if aws.StringValue(input.Family) == "" {
+		return nil, fmt.Errorf("family is required")
+	}
+
+	for i, v := range input.ContainerDefinitions {
+		if v == nil {
+			return nil, fmt.Errorf("invalid container definition supplied at index (%d)", i)
+		}
+	}
+
+	return input, nil
+}
+
+// flattenTaskDefinitionDocument returns the canonical JSON form of the request, with sorted keys.
+func flattenTaskDefinitionDocument(input *ecs.RegisterTaskDefinitionInput) (string, error) {
+	b, err := jsonutil.BuildJSON(input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return structure.NormalizeJsonString(string(b))
+}
+
+// taskDefinitionDocumentUnknownFields returns the paths of the fields in the document that are not
+// fields of the decoded request, in sorted order.
+func taskDefinitionDocumentUnknownFields(document []byte, input *ecs.RegisterTaskDefinitionInput) ([]string, error) {
+	b, err := jsonutil.BuildJSON(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var original, decoded interface{}
+
+	if err := json.Unmarshal(document, &original); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		return nil, err
+	}
+
+	unknown := unknownJSONFields("", original, decoded)
+	sort.Strings(unknown)
+
+	return unknown, nil
+}
+
+func unknownJSONFields(path string, original, decoded interface{}) []string {
+	var unknown []string
+
+	switch original := original.(type) {
+	case map[string]interface{}:
+		decoded, _ := decoded.(map[string]interface{})
+
+		for k, v := range original {
+			// Null values are dropped by the decoder.
+			if v == nil {
+				continue
+			}
+
+			p := k
+			if path != "" {
+				p = path + "." + k
+			}
+
+			d, ok := decoded[k]
+
+			if !ok {
+				unknown = append(unknown, p)
+				continue
+			}
+
+			unknown = append(unknown, unknownJSONFields(p, v, d)...)
+		}
+	case []interface{}:
+		decoded, _ := decoded.([]interface{})
+
+		for i, v := range original {
+			if i < len(decoded) {
+				unknown = append(unknown, unknownJSONFields(fmt.Sprintf("%s[%d]", path, i), v, decoded[i])...)
+			}
+		}
+	}
+
+	return unknown
+}
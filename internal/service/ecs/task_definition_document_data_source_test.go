@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECSTaskDefinitionDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ecs_task_definition_document.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionDocumentDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "container_definitions", `[{"environment":[{"name":"A","value":"1"},{"name":"B","value":"2"}],"essential":true,"image":"nginx:latest","name":"web","portMappings":[{"containerPort":80,"protocol":"tcp"}]}]`),
+					resource.TestCheckResourceAttr(dataSourceName, "cpu", "256"),
+					resource.TestCheckResourceAttr(dataSourceName, "ephemeral_storage.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "ephemeral_storage.0.size_in_gib", "30"),
+					resource.TestCheckResourceAttr(dataSourceName, "family", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "json", fmt.Sprintf(`{"containerDefinitions":[{"environment":[{"name":"A","value":"1"},{"name":"B","value":"2"}],"essential":true,"image":"nginx:latest","name":"web","portMappings":[{"containerPort":80,"protocol":"tcp"}]}],"cpu":"256","ephemeralStorage":{"sizeInGiB":30},"family":%[1]q,"memory":"512","networkMode":"awsvpc","requiresCompatibilities":["FARGATE"],"runtimePlatform":{"cpuArchitecture":"ARM64","operatingSystemFamily":"LINUX"},"tags":[{"key":"Name","value":%[1]q}],"volumes":[{"name":"scratch"}]}`, rName)),
+					resource.TestCheckResourceAttr(dataSourceName, "memory", "512"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_mode", "awsvpc"),
+					resource.TestCheckResourceAttr(dataSourceName, "requires_compatibilities.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "requires_compatibilities.*", "FARGATE"),
+					resource.TestCheckResourceAttr(dataSourceName, "runtime_platform.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "runtime_platform.0.cpu_architecture", "ARM64"),
+					resource.TestCheckResourceAttr(dataSourceName, "runtime_platform.0.operating_system_family", "LINUX"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "volume.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "volume.*", map[string]string{
+						"name": "scratch",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSTaskDefinitionDocumentDataSource_unsupportedField(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTaskDefinitionDocumentDataSourceConfig_unsupportedField(),
+				ExpectError: regexp.MustCompile(`unsupported task definition fields: containerDefinitions\[0\]\.imagee, notAField`),
+			},
+		},
+	})
+}
+
+func TestAccECSTaskDefinitionDocumentDataSource_taskDefinition(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ecs_task_definition_document.test"
+	resourceName := "aws_ecs_task_definition.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionDocumentDataSourceConfig_taskDefinition(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "cpu", dataSourceName, "cpu"),
+					resource.TestCheckResourceAttrPair(resourceName, "family", dataSourceName, "family"),
+					resource.TestCheckResourceAttrPair(resourceName, "memory", dataSourceName, "memory"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_mode", dataSourceName, "network_mode"),
+					resource.TestCheckResourceAttrPair(resourceName, "runtime_platform.0.cpu_architecture", dataSourceName, "runtime_platform.0.cpu_architecture"),
+					resource.TestCheckResourceAttrPair(resourceName, "tags.%", dataSourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(resourceName, "volume.#", dataSourceName, "volume.#"),
+				),
+			},
+			{
+				Config:   testAccTaskDefinitionDocumentDataSourceConfig_taskDefinition(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// testAccTaskDefinitionDocumentDataSourceConfig_document is in the format returned by
+// "aws ecs describe-task-definition --include TAGS", including read-only fields.
+func testAccTaskDefinitionDocumentDataSourceConfig_document(rName string) string {
+	return fmt.Sprintf(`
+locals {
+  document = jsonencode({
+    taskDefinition = {
+      taskDefinitionArn = "arn:aws:ecs:us-west-2:123456789012:task-definition/%[1]s:1"
+      family            = %[1]q
+      revision          = 1
+      status            = "ACTIVE"
+      containerDefinitions = [{
+        name      = "web"
+        image     = "nginx:latest"
+        essential = true
+        portMappings = [{
+          containerPort = 80
+          protocol      = "tcp"
+        }]
+        environment = [
+          { name = "B", value = "2" },
+          { name = "A", value = "1" },
+        ]
+      }]
+      cpu                     = "256"
+      memory                  = "512"
+      networkMode             = "awsvpc"
+      requiresCompatibilities = ["FARGATE"]
+      compatibilities         = ["EC2", "FARGATE"]
+      ephemeralStorage = {
+        sizeInGiB = 30
+      }
+      runtimePlatform = {
+        cpuArchitecture       = "ARM64"
+        operatingSystemFamily = "LINUX"
+      }
+      volumes = [{
+        name = "scratch"
+      }]
+      registeredAt = "2023-08-01T12:00:00.000000+00:00"
+      registeredBy = "arn:aws:iam::123456789012:user/example"
+    }
+    tags = [{
+      key   = "Name"
+      value = %[1]q
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccTaskDefinitionDocumentDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTaskDefinitionDocumentDataSourceConfig_document(rName), `
+data "aws_ecs_task_definition_document" "test" {
+  source_json = local.document
+}
+`)
+}
+
+func testAccTaskDefinitionDocumentDataSourceConfig_unsupportedField() string {
+	return `
+data "aws_ecs_task_definition_document" "test" {
+  source_json = jsonencode({
+    family    = "test"
+    notAField = true
+    containerDefinitions = [
+      {
+        name   = "web"
+        image  = "nginx"
+        imagee = "nginx:latest"
+      },
+    ]
+  })
+}
+`
+}
+
+func testAccTaskDefinitionDocumentDataSourceConfig_taskDefinition(rName string) string {
+	return acctest.ConfigCompose(testAccTaskDefinitionDocumentDataSourceConfig_basic(rName), `
+resource "aws_ecs_task_definition" "test" {
+  family                   = data.aws_ecs_task_definition_document.test.family
+  container_definitions    = data.aws_ecs_task_definition_document.test.container_definitions
+  cpu                      = data.aws_ecs_task_definition_document.test.cpu
+  memory                   = data.aws_ecs_task_definition_document.test.memory
+  network_mode             = data.aws_ecs_task_definition_document.test.network_mode
+  requires_compatibilities = data.aws_ecs_task_definition_document.test.requires_compatibilities
+  tags                     = data.aws_ecs_task_definition_document.test.tags
+
+  dynamic "ephemeral_storage" {
+    for_each = data.aws_ecs_task_definition_document.test.ephemeral_storage
+
+    content {
+      size_in_gib = ephemeral_storage.value.size_in_gib
+    }
+  }
+
+  dynamic "runtime_platform" {
+    for_each = data.aws_ecs_task_definition_document.test.runtime_platform
+
+    content {
+      cpu_architecture        = runtime_platform.value.cpu_architecture
+      operating_system_family = runtime_platform.value.operating_system_family
+    }
+  }
+
+  dynamic "volume" {
+    for_each = data.aws_ecs_task_definition_document.test.volume
+
+    content {
+      name      = volume.value.name
+      host_path = volume.value.host_path
+    }
+  }
+}
+`)
+}
@@ -5,11 +5,13 @@ package ecs
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -66,26 +68,30 @@ func waitCapacityProviderUpdated(ctx context.Context, conn *ecs.ECS, arn string)
 }
 
 // waitServiceStable waits for an ECS Service to reach the status "ACTIVE" and have all desired tasks running. Does not return tags.
+// Deployment progress is logged while waiting. If the primary deployment fails, e.g. because the deployment circuit breaker
+// or a deployment alarm fired, a *serviceDeploymentFailedError is returned. A deployment that is superseded by another
+// deployment is not a failure; the new primary deployment is waited on instead.
 func waitServiceStable(ctx context.Context, conn *ecs.ECS, id, cluster string, timeout time.Duration) (*ecs.Service, error) {
-	input := &ecs.DescribeServicesInput{
-		Services: aws.StringSlice([]string{id}),
-	}
-
-	if cluster != "" {
-		input.Cluster = aws.String(cluster)
-	}
-
+	progress := newServiceDeploymentProgress(id)
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{serviceStatusInactive, serviceStatusDraining, serviceStatusPending},
 		Target:  []string{serviceStatusStable},
-		Refresh: statusServiceWaitForStable(ctx, conn, id, cluster),
+		Refresh: statusServiceWaitForStable(ctx, conn, id, cluster, progress),
 		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if v, ok := outputRaw.(*ecs.Service); ok {
-		return v, err
+	if output, ok := outputRaw.(*ecs.Service); ok {
+		if progress.failed(output) {
+			return output, progress.failure(ctx, conn, cluster)
+		}
+
+		return output, err
+	}
+
+	if summary := progress.summary(); summary != "" {
+		tfresource.SetLastError(err, errors.New(summary))
 	}
 
 	return nil, err
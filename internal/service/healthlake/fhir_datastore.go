@@ -0,0 +1,504 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_healthlake_fhir_datastore", name="FHIR Datastore")
+// @Tags(identifierAttribute="arn")
+func ResourceFHIRDatastore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFHIRDatastoreCreate,
+		ReadWithoutTimeout:   resourceFHIRDatastoreRead,
+		UpdateWithoutTimeout: resourceFHIRDatastoreUpdate,
+		DeleteWithoutTimeout: resourceFHIRDatastoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_type_version": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.FHIRVersion](),
+			},
+			"identity_provider_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorization_strategy": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.AuthorizationStrategy](),
+						},
+						"fine_grained_authorization_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"idp_lambda_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"metadata": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[\p{L}\p{Z}\p{N}_.:/=+\-@]*$`), "must contain only letters, numbers, spaces and the characters _.:/=+-@"),
+				),
+			},
+			"preload_data_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"preload_data_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.PreloadDataType](),
+						},
+					},
+				},
+			},
+			"sse_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_encryption_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cmk_type": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.CmkType](),
+									},
+									"kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 400),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameFHIRDatastore = "FHIR Datastore"
+)
+
+func resourceFHIRDatastoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).HealthLakeClient(ctx)
+
+	in := &healthlake.CreateFHIRDatastoreInput{
+		ClientToken:          aws.String(id.UniqueId()),
+		DatastoreTypeVersion: types.FHIRVersion(d.Get("datastore_type_version").(string)),
+		Tags:                 getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("identity_provider_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.IdentityProviderConfiguration = expandIdentityProviderConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		in.DatastoreName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("preload_data_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.PreloadDataConfig = &types.PreloadDataConfig{
+			PreloadDataType: types.PreloadDataType(v.([]interface{})[0].(map[string]interface{})["preload_data_type"].(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("sse_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.SseConfiguration = expandSSEConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	out, err := conn.CreateFHIRDatastore(ctx, in)
+	if err != nil {
+		return create.DiagError(names.HealthLake, create.ErrActionCreating, ResNameFHIRDatastore, d.Get("name").(string), err)
+	}
+
+	if out == nil {
+		return create.DiagError(names.HealthLake, create.ErrActionCreating, ResNameFHIRDatastore, d.Get("name").(string), errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(out.DatastoreId))
+
+	if _, err := waitFHIRDatastoreCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.HealthLake, create.ErrActionWaitingForCreation, ResNameFHIRDatastore, d.Id(), err)
+	}
+
+	return resourceFHIRDatastoreRead(ctx, d, meta)
+}
+
+func resourceFHIRDatastoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).HealthLakeClient(ctx)
+
+	out, err := FindFHIRDatastoreByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] HealthLake FHIR Datastore (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.HealthLake, create.ErrActionReading, ResNameFHIRDatastore, d.Id(), err)
+	}
+
+	if err := setFHIRDatastoreProperties(d, out); err != nil {
+		return create.DiagError(names.HealthLake, create.ErrActionSetting, ResNameFHIRDatastore, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceFHIRDatastoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceFHIRDatastoreRead(ctx, d, meta)
+}
+
+func resourceFHIRDatastoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).HealthLakeClient(ctx)
+
+	log.Printf("[INFO] Deleting HealthLake FHIR Datastore: %s", d.Id())
+	_, err := conn.DeleteFHIRDatastore(ctx, &healthlake.DeleteFHIRDatastoreInput{
+		DatastoreId: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil
+		}
+
+		return create.DiagError(names.HealthLake, create.ErrActionDeleting, ResNameFHIRDatastore, d.Id(), err)
+	}
+
+	if _, err := waitFHIRDatastoreDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.HealthLake, create.ErrActionWaitingForDeletion, ResNameFHIRDatastore, d.Id(), err)
+	}
+
+	return nil
+}
+
+// setFHIRDatastoreProperties sets the attributes shared by the resource and data source.
+func setFHIRDatastoreProperties(d *schema.ResourceData, apiObject *types.DatastoreProperties) error {
+	d.Set("arn", apiObject.DatastoreArn)
+	if apiObject.CreatedAt != nil {
+		d.Set("created_at", aws.ToTime(apiObject.CreatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("created_at", nil)
+	}
+	d.Set("datastore_endpoint", apiObject.DatastoreEndpoint)
+	d.Set("datastore_type_version", apiObject.DatastoreTypeVersion)
+	if apiObject.IdentityProviderConfiguration != nil {
+		if err := d.Set("identity_provider_configuration", []interface{}{flattenIdentityProviderConfiguration(apiObject.IdentityProviderConfiguration)}); err != nil {
+			return err
+		}
+	} else {
+		d.Set("identity_provider_configuration", nil)
+	}
+	d.Set("name", apiObject.DatastoreName)
+	if apiObject.PreloadDataConfig != nil {
+		if err := d.Set("preload_data_config", []interface{}{map[string]interface{}{
+			"preload_data_type": string(apiObject.PreloadDataConfig.PreloadDataType),
+		}}); err != nil {
+			return err
+		}
+	} else {
+		d.Set("preload_data_config", nil)
+	}
+	if apiObject.SseConfiguration != nil {
+		if err := d.Set("sse_configuration", []interface{}{flattenSSEConfiguration(apiObject.SseConfiguration)}); err != nil {
+			return err
+		}
+	} else {
+		d.Set("sse_configuration", nil)
+	}
+	d.Set("status", apiObject.DatastoreStatus)
+
+	return nil
+}
+
+func waitFHIRDatastoreCreated(ctx context.Context, conn *healthlake.Client, id string, timeout time.Duration) (*types.DatastoreProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.DatastoreStatusCreating),
+		Target:                    enum.Slice(types.DatastoreStatusActive),
+		Refresh:                   statusFHIRDatastore(ctx, conn, id),
+		Timeout:                   timeout,
+		MinTimeout:                10 * time.Second,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*types.DatastoreProperties); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitFHIRDatastoreDeleted(ctx context.Context, conn *healthlake.Client, id string, timeout time.Duration) (*types.DatastoreProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.DatastoreStatusDeleting, types.DatastoreStatusActive),
+		Target:     []string{},
+		Refresh:    statusFHIRDatastore(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*types.DatastoreProperties); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusFHIRDatastore(ctx context.Context, conn *healthlake.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindFHIRDatastoreByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.DatastoreStatus), nil
+	}
+}
+
+func FindFHIRDatastoreByID(ctx context.Context, conn *healthlake.Client, id string) (*types.DatastoreProperties, error) {
+	in := &healthlake.DescribeFHIRDatastoreInput{
+		DatastoreId: aws.String(id),
+	}
+	out, err := conn.DescribeFHIRDatastore(ctx, in)
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || out.DatastoreProperties == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	// Deleted datastores remain visible for a while with a status of DELETED.
+	if status := out.DatastoreProperties.DatastoreStatus; status == types.DatastoreStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: in,
+		}
+	}
+
+	return out.DatastoreProperties, nil
+}
+
+func FindFHIRDatastoreByName(ctx context.Context, conn *healthlake.Client, name string) (*types.DatastoreProperties, error) {
+	in := &healthlake.ListFHIRDatastoresInput{
+		Filter: &types.DatastoreFilter{
+			DatastoreName: aws.String(name),
+		},
+	}
+	var output []types.DatastoreProperties
+
+	pages := healthlake.NewListFHIRDatastoresPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.DatastorePropertiesList {
+			if aws.ToString(v.DatastoreName) == name && v.DatastoreStatus != types.DatastoreStatusDeleted {
+				output = append(output, v)
+			}
+		}
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, in)
+	}
+
+	return &output[0], nil
+}
+
+func expandIdentityProviderConfiguration(tfMap map[string]interface{}) *types.IdentityProviderConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.IdentityProviderConfiguration{
+		AuthorizationStrategy: types.AuthorizationStrategy(tfMap["authorization_strategy"].(string)),
+	}
+
+	if v, ok := tfMap["fine_grained_authorization_enabled"].(bool); ok {
+		apiObject.FineGrainedAuthorizationEnabled = v
+	}
+
+	if v, ok := tfMap["idp_lambda_arn"].(string); ok && v != "" {
+		apiObject.IdpLambdaArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["metadata"].(string); ok && v != "" {
+		apiObject.Metadata = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenIdentityProviderConfiguration(apiObject *types.IdentityProviderConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"authorization_strategy":             string(apiObject.AuthorizationStrategy),
+		"fine_grained_authorization_enabled": apiObject.FineGrainedAuthorizationEnabled,
+		"idp_lambda_arn":                     aws.ToString(apiObject.IdpLambdaArn),
+		"metadata":                           aws.ToString(apiObject.Metadata),
+	}
+}
+
+func expandSSEConfiguration(tfMap map[string]interface{}) *types.SseConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.SseConfiguration{}
+
+	if v, ok := tfMap["kms_encryption_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		kmsEncryptionConfig := &types.KmsEncryptionConfig{
+			CmkType: types.CmkType(tfMap["cmk_type"].(string)),
+		}
+
+		if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+			kmsEncryptionConfig.KmsKeyId = aws.String(v)
+		}
+
+		apiObject.KmsEncryptionConfig = kmsEncryptionConfig
+	}
+
+	return apiObject
+}
+
+func flattenSSEConfiguration(apiObject *types.SseConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.KmsEncryptionConfig; v != nil {
+		tfMap["kms_encryption_config"] = []interface{}{map[string]interface{}{
+			"cmk_type":   string(v.CmkType),
+			"kms_key_id": aws.ToString(v.KmsKeyId),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_healthlake_fhir_datastore")
+func DataSourceFHIRDatastore() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFHIRDatastoreRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_type_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity_provider_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorization_strategy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fine_grained_authorization_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"idp_lambda_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metadata": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"preload_data_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"preload_data_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"sse_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_encryption_config": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cmk_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"kms_key_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+const (
+	DSNameFHIRDatastore = "FHIR Datastore Data Source"
+)
+
+func dataSourceFHIRDatastoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).HealthLakeClient(ctx)
+
+	name := d.Get("name").(string)
+	out, err := FindFHIRDatastoreByName(ctx, conn, name)
+	if err != nil {
+		return create.DiagError(names.HealthLake, create.ErrActionReading, DSNameFHIRDatastore, name, tfresource.SingularDataSourceFindError("HealthLake FHIR Datastore", err))
+	}
+
+	d.SetId(aws.ToString(out.DatastoreId))
+
+	if err := setFHIRDatastoreProperties(d, out); err != nil {
+		return create.DiagError(names.HealthLake, create.ErrActionSetting, DSNameFHIRDatastore, d.Id(), err)
+	}
+
+	tags, err := listTags(ctx, conn, aws.ToString(out.DatastoreArn))
+	if err != nil {
+		return create.DiagError(names.HealthLake, create.ErrActionReading, DSNameFHIRDatastore, d.Id(), err)
+	}
+
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return create.DiagError(names.HealthLake, create.ErrActionSetting, DSNameFHIRDatastore, d.Id(), err)
+	}
+
+	return nil
+}
@@ -234,3 +234,45 @@ func FindAccessKeys(ctx context.Context, conn *iam.IAM, username string) ([]*iam
 
 	return output, err
 }
+
+// findServiceLastAccessedDetailsByJobID returns the details of the specified service last accessed job.
+// The services of all pages are returned in the ServicesLastAccessed field of the result.
+func findServiceLastAccessedDetailsByJobID(ctx context.Context, conn *iam.IAM, id string) (*iam.GetServiceLastAccessedDetailsOutput, error) {
+	input := &iam.GetServiceLastAccessedDetailsInput{
+		JobId: aws.String(id),
+	}
+	var result *iam.GetServiceLastAccessedDetailsOutput
+
+	for {
+		output, err := conn.GetServiceLastAccessedDetailsWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			return nil, tfresource.NewEmptyResultError(input)
+		}
+
+		if result == nil {
+			result = output
+		} else {
+			result.ServicesLastAccessed = append(result.ServicesLastAccessed, output.ServicesLastAccessed...)
+		}
+
+		if !aws.BoolValue(output.IsTruncated) {
+			break
+		}
+
+		input.Marker = output.Marker
+	}
+
+	return result, nil
+}
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -17,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 )
 
 var dataSourcePolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
@@ -35,10 +38,35 @@ func DataSourcePolicyDocument() *schema.Resource {
 		ReadWithoutTimeout: dataSourcePolicyDocumentRead,
 
 		Schema: map[string]*schema.Schema{
+			"action_wildcards": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^:]+:.*\*`), `must be in the format "service:Prefix*"`),
+				},
+			},
 			"json": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"json_chunk_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"json_chunks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"merge_statements": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"override_policy_documents": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -261,6 +289,18 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if v, ok := d.GetOk("action_wildcards"); ok && v.(*schema.Set).Len() > 0 {
+		patterns := flex.ExpandStringValueSet(v.(*schema.Set))
+		sort.Strings(patterns)
+		mergedDoc.CollapseActions(patterns)
+	}
+
+	if d.Get("merge_statements").(bool) {
+		if err := mergedDoc.MergeStatements(); err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging statements: %s", err)
+		}
+	}
+
 	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
 	if err != nil {
 		// should never happen if the above code is correct
@@ -268,7 +308,31 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	}
 	jsonString := string(jsonDoc)
 
+	var jsonChunks []string
+
+	// Chunks are only built when requested, so that large statements don't affect documents that aren't split.
+	if v, ok := d.GetOk("json_chunk_size"); ok {
+		chunkSize := v.(int)
+		chunks, oversized, err := mergedDoc.Chunks(chunkSize)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: splitting into chunks: %s", err)
+		}
+
+		for _, i := range oversized {
+			diags = sdkdiag.AppendWarningf(diags, "IAM Policy Document statement %d (%s) is larger than json_chunk_size (%d) and is in a chunk of its own", i, mergedDoc.Statements[i].Sid, chunkSize)
+		}
+
+		for _, chunk := range chunks {
+			jsonChunk, err := json.MarshalIndent(chunk, "", "  ")
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: formatting JSON: %s", err)
+			}
+			jsonChunks = append(jsonChunks, string(jsonChunk))
+		}
+	}
+
 	d.Set("json", jsonString)
+	d.Set("json_chunks", jsonChunks)
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
 	return diags
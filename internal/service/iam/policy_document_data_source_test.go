@@ -4,16 +4,55 @@
 package iam_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 )
 
+// A statement larger than the maximum size of a customer managed policy must not prevent
+// the document from being read when no json_chunk_size is set.
+func TestPolicyDocumentDataSourceRead_largeStatement(t *testing.T) {
+	t.Parallel()
+
+	var resources []interface{}
+	for i := 0; i < 200; i++ {
+		resources = append(resources, fmt.Sprintf("arn:aws:s3:::example-bucket-with-a-long-name-%d/*", i)) // lintignore:AWSAT005
+	}
+
+	dataSource := tfiam.DataSourcePolicyDocument()
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{
+		"statement": []interface{}{
+			map[string]interface{}{
+				"actions":   []interface{}{"s3:GetObject"},
+				"resources": resources,
+			},
+		},
+	})
+
+	diags := dataSource.ReadWithoutTimeout(context.Background(), d, nil)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := len(strings.Join(strings.Fields(d.Get("json").(string)), "")); got <= 6144 {
+		t.Fatalf("expected a statement larger than 6144 characters, got %d", got)
+	}
+
+	if got := len(d.Get("json_chunks").([]interface{})); got != 0 {
+		t.Errorf("expected no json_chunks, got %d", got)
+	}
+}
+
 func TestAccIAMPolicyDocumentDataSource_basic(t *testing.T) {
 	// This really ought to be able to be a unit test rather than an
 	// acceptance test, but just instantiating the AWS provider requires
@@ -417,6 +456,57 @@ func TestAccIAMPolicyDocumentDataSource_version20081017(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_mergeStatements(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_mergeStatements,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccPolicyDocumentMergeStatementsExpectedJSON()),
+					resource.TestCheckResourceAttr(dataSourceName, "json_chunks.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_jsonChunks(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_jsonChunks(150),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccPolicyDocumentJSONChunksExpectedJSON),
+					resource.TestCheckResourceAttr(dataSourceName, "json_chunks.#", "2"),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json_chunks.0", testAccPolicyDocumentJSONChunksExpectedJSONChunk0),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json_chunks.1", testAccPolicyDocumentJSONChunksExpectedJSONChunk1),
+				),
+			},
+			{
+				// Each statement is larger than the chunk size, so each is in a chunk of its own.
+				Config: testAccPolicyDocumentDataSourceConfig_jsonChunks(50),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json_chunks.#", "2"),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json_chunks.0", testAccPolicyDocumentJSONChunksExpectedJSONChunk0),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json_chunks.1", testAccPolicyDocumentJSONChunksExpectedJSONChunk1),
+				),
+			},
+		},
+	})
+}
+
 var testAccPolicyDocumentDataSourceConfig_basic = `
 data "aws_partition" "current" {}
 
@@ -1548,3 +1638,105 @@ data "aws_iam_policy_document" "test" {
   override_policy_documents = ["{"]
 }
 `
+
+const testAccPolicyDocumentDataSourceConfig_mergeStatements = `
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "test" {
+  merge_statements = true
+  action_wildcards = ["s3:GetObject*"]
+
+  statement {
+    actions   = ["s3:GetObject", "s3:GetObjectAcl"]
+    resources = ["arn:${data.aws_partition.current.partition}:s3:::example/*"]
+  }
+
+  statement {
+    actions   = ["s3:PutObject"]
+    resources = ["arn:${data.aws_partition.current.partition}:s3:::example/*"]
+  }
+
+  statement {
+    actions   = ["s3:ListBucket"]
+    resources = ["arn:${data.aws_partition.current.partition}:s3:::example"]
+  }
+}
+`
+
+func testAccPolicyDocumentMergeStatementsExpectedJSON() string {
+	return fmt.Sprintf(`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:PutObject",
+        "s3:GetObject*"
+      ],
+      "Resource": "arn:%[1]s:s3:::example/*"
+    },
+    {
+      "Effect": "Allow",
+      "Action": "s3:ListBucket",
+      "Resource": "arn:%[1]s:s3:::example"
+    }
+  ]
+}`, acctest.Partition())
+}
+
+func testAccPolicyDocumentDataSourceConfig_jsonChunks(chunkSize int) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  json_chunk_size = %[1]d
+
+  statement {
+    actions   = ["ec2:DescribeInstances"]
+    resources = ["*"]
+  }
+
+  statement {
+    actions   = ["ec2:DescribeVpcs"]
+    resources = ["*"]
+    effect    = "Deny"
+  }
+}
+`, chunkSize)
+}
+
+const testAccPolicyDocumentJSONChunksExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "ec2:DescribeInstances",
+      "Resource": "*"
+    },
+    {
+      "Effect": "Deny",
+      "Action": "ec2:DescribeVpcs",
+      "Resource": "*"
+    }
+  ]
+}`
+
+const testAccPolicyDocumentJSONChunksExpectedJSONChunk0 = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "ec2:DescribeInstances",
+      "Resource": "*"
+    }
+  ]
+}`
+
+const testAccPolicyDocumentJSONChunksExpectedJSONChunk1 = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Deny",
+      "Action": "ec2:DescribeVpcs",
+      "Resource": "*"
+    }
+  ]
+}`
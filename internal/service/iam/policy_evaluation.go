@@ -0,0 +1,778 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// Decisions returned by local policy evaluation, matching those returned by the IAM policy simulator.
+const (
+	policyEvaluationDecisionAllowed      = "allowed"
+	policyEvaluationDecisionExplicitDeny = "explicitDeny"
+	policyEvaluationDecisionImplicitDeny = "implicitDeny"
+)
+
+const (
+	policyEvaluationPolicyTypeIdentity            = "identity"
+	policyEvaluationPolicyTypePermissionsBoundary = "permissions_boundary"
+	policyEvaluationPolicyTypeResource            = "resource"
+	policyEvaluationPolicyTypeServiceControl      = "service_control"
+)
+
+const (
+	policyEvaluationEffectAllow = "Allow"
+	policyEvaluationEffectDeny  = "Deny"
+
+	// Policy variables are only substituted in policies with this version.
+	policyEvaluationVariablesVersion = "2012-10-17"
+)
+
+type policyEvaluationPolicy struct {
+	Type       string
+	Index      int
+	Version    string
+	Statements []*policyEvaluationStatement
+}
+
+type policyEvaluationStatement struct {
+	Index         int
+	Sid           string
+	Effect        string
+	Actions       []string
+	NotActions    []string
+	Resources     []string
+	NotResources  []string
+	Principals    map[string][]string
+	NotPrincipals map[string][]string
+	Conditions    []*policyEvaluationCondition
+}
+
+type policyEvaluationCondition struct {
+	Operator string
+	Key      string
+	Values   []string
+}
+
+type policyEvaluationRequest struct {
+	Action    string
+	Resource  string
+	Principal string
+	// Context values keyed by lower-case context key name.
+	Context map[string][]string
+}
+
+type policyEvaluationResult struct {
+	Decision           string
+	PolicyType         string
+	PolicyIndex        int
+	Statement          *policyEvaluationStatement
+	MissingContextKeys []string
+}
+
+// policyEvaluationPolicies are the policies that apply to a request.
+type policyEvaluationPolicies struct {
+	Identity            []*policyEvaluationPolicy
+	Resource            []*policyEvaluationPolicy
+	PermissionsBoundary []*policyEvaluationPolicy
+	ServiceControl      []*policyEvaluationPolicy
+}
+
+type policyEvaluationRawStatement struct {
+	Sid          string
+	Effect       string
+	Action       interface{}
+	NotAction    interface{}
+	Resource     interface{}
+	NotResource  interface{}
+	Principal    interface{}
+	NotPrincipal interface{}
+	Condition    map[string]map[string]interface{}
+}
+
+// parsePolicyEvaluationPolicy parses a JSON policy document for local evaluation.
+func parsePolicyEvaluationPolicy(policyType string, index int, document string) (*policyEvaluationPolicy, error) {
+	var raw struct {
+		Version   string
+		Statement json.RawMessage
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(document))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	var rawStatements []*policyEvaluationRawStatement
+
+	if statement := bytes.TrimSpace(raw.Statement); len(statement) > 0 && statement[0] == '{' {
+		rawStatements = make([]*policyEvaluationRawStatement, 1)
+		if err := policyEvaluationUnmarshal(statement, &rawStatements[0]); err != nil {
+			return nil, err
+		}
+	} else if len(statement) > 0 {
+		if err := policyEvaluationUnmarshal(statement, &rawStatements); err != nil {
+			return nil, err
+		}
+	}
+
+	policy := &policyEvaluationPolicy{
+		Type:    policyType,
+		Index:   index,
+		Version: raw.Version,
+	}
+
+	for i, rawStatement := range rawStatements {
+		statement, err := expandPolicyEvaluationStatement(i, rawStatement)
+
+		if err != nil {
+			if rawStatement.Sid != "" {
+				return nil, fmt.Errorf("statement %q: %w", rawStatement.Sid, err)
+			}
+
+			return nil, fmt.Errorf("statement %d: %w", i, err)
+		}
+
+		policy.Statements = append(policy.Statements, statement)
+	}
+
+	return policy, nil
+}
+
+func policyEvaluationUnmarshal(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	return decoder.Decode(v)
+}
+
+func expandPolicyEvaluationStatement(index int, raw *policyEvaluationRawStatement) (*policyEvaluationStatement, error) {
+	statement := &policyEvaluationStatement{
+		Index: index,
+		Sid:   raw.Sid,
+	}
+
+	switch raw.Effect {
+	case policyEvaluationEffectAllow, policyEvaluationEffectDeny:
+		statement.Effect = raw.Effect
+	default:
+		return nil, fmt.Errorf("unsupported Effect %q", raw.Effect)
+	}
+
+	var err error
+
+	for _, v := range []struct {
+		raw    interface{}
+		target *[]string
+		name   string
+	}{
+		{raw.Action, &statement.Actions, "Action"},
+		{raw.NotAction, &statement.NotActions, "NotAction"},
+		{raw.Resource, &statement.Resources, "Resource"},
+		{raw.NotResource, &statement.NotResources, "NotResource"},
+	} {
+		if *v.target, err = policyEvaluationStringList(v.raw); err != nil {
+			return nil, fmt.Errorf("%s: %w", v.name, err)
+		}
+	}
+
+	if statement.Actions == nil && statement.NotActions == nil {
+		return nil, fmt.Errorf("one of Action or NotAction is required")
+	}
+
+	if statement.Principals, err = policyEvaluationPrincipals(raw.Principal); err != nil {
+		return nil, fmt.Errorf("Principal: %w", err)
+	}
+
+	if statement.NotPrincipals, err = policyEvaluationPrincipals(raw.NotPrincipal); err != nil {
+		return nil, fmt.Errorf("NotPrincipal: %w", err)
+	}
+
+	for operator, keys := range raw.Condition {
+		if _, _, _, err := parsePolicyEvaluationConditionOperator(operator); err != nil {
+			return nil, fmt.Errorf("Condition: %w", err)
+		}
+
+		for key, rawValues := range keys {
+			values, err := policyEvaluationStringList(rawValues)
+
+			if err != nil {
+				return nil, fmt.Errorf("Condition %s %s: %w", operator, key, err)
+			}
+
+			statement.Conditions = append(statement.Conditions, &policyEvaluationCondition{
+				Operator: operator,
+				Key:      key,
+				Values:   values,
+			})
+		}
+	}
+
+	// Evaluate conditions in a consistent order.
+	sort.Slice(statement.Conditions, func(i, j int) bool {
+		if statement.Conditions[i].Operator != statement.Conditions[j].Operator {
+			return statement.Conditions[i].Operator < statement.Conditions[j].Operator
+		}
+
+		return statement.Conditions[i].Key < statement.Conditions[j].Key
+	})
+
+	return statement, nil
+}
+
+// policyEvaluationStringList converts a policy element that may be a scalar or a list of scalars to a list of strings.
+func policyEvaluationStringList(v interface{}) ([]string, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case json.Number:
+		return []string{v.String()}, nil
+	case bool:
+		return []string{strconv.FormatBool(v)}, nil
+	case []interface{}:
+		values := make([]string, 0, len(v))
+
+		for _, v := range v {
+			switch v.(type) {
+			case []interface{}, map[string]interface{}:
+				return nil, fmt.Errorf("unsupported data type %T", v)
+			}
+
+			value, err := policyEvaluationStringList(v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, value...)
+		}
+
+		return values, nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %T", v)
+	}
+}
+
+func policyEvaluationPrincipals(v interface{}) (map[string][]string, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if v != "*" {
+			return nil, fmt.Errorf("unsupported value %q", v)
+		}
+
+		return map[string][]string{"*": {"*"}}, nil
+	case map[string]interface{}:
+		principals := make(map[string][]string, len(v))
+
+		for principalType, identifiers := range v {
+			values, err := policyEvaluationStringList(identifiers)
+
+			if err != nil {
+				return nil, err
+			}
+
+			principals[principalType] = values
+		}
+
+		return principals, nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %T", v)
+	}
+}
+
+// evaluatePolicies evaluates a request against a set of policies using the IAM policy evaluation logic for a request
+// within a single account:
+//
+//  1. An explicit Deny in any policy denies the request.
+//  2. Each service control policy must allow the request.
+//  3. An Allow in a resource-based policy allows the request.
+//  4. Each permissions boundary must allow the request.
+//  5. An Allow in an identity-based policy allows the request.
+//
+// Otherwise the request is implicitly denied.
+func evaluatePolicies(request *policyEvaluationRequest, policies *policyEvaluationPolicies) *policyEvaluationResult {
+	missing := make(map[string]struct{})
+	result := func(decision string, policy *policyEvaluationPolicy, statement *policyEvaluationStatement) *policyEvaluationResult {
+		result := &policyEvaluationResult{
+			Decision:  decision,
+			Statement: statement,
+		}
+
+		if policy != nil {
+			result.PolicyType = policy.Type
+			result.PolicyIndex = policy.Index
+		}
+
+		for key := range missing {
+			result.MissingContextKeys = append(result.MissingContextKeys, key)
+		}
+		sort.Strings(result.MissingContextKeys)
+
+		return result
+	}
+
+	for _, v := range [][]*policyEvaluationPolicy{policies.Identity, policies.Resource, policies.PermissionsBoundary, policies.ServiceControl} {
+		for _, policy := range v {
+			if statement := policy.match(request, policyEvaluationEffectDeny, missing); statement != nil {
+				return result(policyEvaluationDecisionExplicitDeny, policy, statement)
+			}
+		}
+	}
+
+	for _, policy := range policies.ServiceControl {
+		if statement := policy.match(request, policyEvaluationEffectAllow, missing); statement == nil {
+			return result(policyEvaluationDecisionImplicitDeny, policy, nil)
+		}
+	}
+
+	for _, policy := range policies.Resource {
+		if statement := policy.match(request, policyEvaluationEffectAllow, missing); statement != nil {
+			return result(policyEvaluationDecisionAllowed, policy, statement)
+		}
+	}
+
+	for _, policy := range policies.PermissionsBoundary {
+		if statement := policy.match(request, policyEvaluationEffectAllow, missing); statement == nil {
+			return result(policyEvaluationDecisionImplicitDeny, policy, nil)
+		}
+	}
+
+	for _, policy := range policies.Identity {
+		if statement := policy.match(request, policyEvaluationEffectAllow, missing); statement != nil {
+			return result(policyEvaluationDecisionAllowed, policy, statement)
+		}
+	}
+
+	return result(policyEvaluationDecisionImplicitDeny, nil, nil)
+}
+
+// match returns the first statement with the specified effect that applies to the request.
+// Context keys that are referenced by the policy but missing from the request are added to missing.
+func (p *policyEvaluationPolicy) match(request *policyEvaluationRequest, effect string, missing map[string]struct{}) *policyEvaluationStatement {
+	for _, statement := range p.Statements {
+		if statement.Effect != effect {
+			continue
+		}
+
+		if statement.matches(request, p.Version == policyEvaluationVariablesVersion, missing) {
+			return statement
+		}
+	}
+
+	return nil
+}
+
+func (s *policyEvaluationStatement) matches(request *policyEvaluationRequest, variables bool, missing map[string]struct{}) bool {
+	if s.Actions != nil && !policyEvaluationMatchAny(s.Actions, request.Action, true) {
+		return false
+	}
+
+	if s.NotActions != nil && policyEvaluationMatchAny(s.NotActions, request.Action, true) {
+		return false
+	}
+
+	// Elements that contain a policy variable with no value are ignored.
+	substitute := func(patterns []string) []string {
+		if !variables {
+			return patterns
+		}
+
+		result := make([]string, 0, len(patterns))
+
+		for _, v := range patterns {
+			if v, ok := request.substitute(v, missing); ok {
+				result = append(result, v)
+			}
+		}
+
+		return result
+	}
+
+	if s.Resources != nil && !policyEvaluationMatchAny(substitute(s.Resources), request.Resource, false) {
+		return false
+	}
+
+	if s.NotResources != nil && policyEvaluationMatchAny(substitute(s.NotResources), request.Resource, false) {
+		return false
+	}
+
+	if s.Principals != nil && !request.matchesPrincipal(s.Principals) {
+		return false
+	}
+
+	if s.NotPrincipals != nil && request.matchesPrincipal(s.NotPrincipals) {
+		return false
+	}
+
+	for _, condition := range s.Conditions {
+		if !request.matchesCondition(condition.Operator, condition.Key, substitute(condition.Values), missing) {
+			return false
+		}
+	}
+
+	return true
+}
+
+var policyEvaluationActionRegexp = regexp.MustCompile(`^[0-9A-Za-z-]+:[0-9A-Za-z]+$`)
+
+var policyEvaluationVariableRegexp = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// substitute replaces policy variables, e.g. "${aws:username}", in a policy element with the values of the corresponding
+// context keys. It returns false if a variable has no value and no default.
+func (r *policyEvaluationRequest) substitute(s string, missing map[string]struct{}) (string, bool) {
+	ok := true
+
+	result := policyEvaluationVariableRegexp.ReplaceAllStringFunc(s, func(match string) string {
+		variable := strings.TrimSpace(match[2 : len(match)-1])
+
+		switch variable {
+		case "*", "?", "$":
+			return variable
+		}
+
+		key, defaultValue, hasDefault := strings.Cut(variable, ",")
+		key = strings.TrimSpace(key)
+
+		if values := r.Context[strings.ToLower(key)]; len(values) > 0 {
+			return values[0]
+		}
+
+		if hasDefault {
+			return strings.Trim(strings.TrimSpace(defaultValue), "'")
+		}
+
+		missing[key] = struct{}{}
+		ok = false
+
+		return match
+	})
+
+	return result, ok
+}
+
+func (r *policyEvaluationRequest) matchesPrincipal(principals map[string][]string) bool {
+	for principalType, identifiers := range principals {
+		for _, identifier := range identifiers {
+			if identifier == "*" {
+				return true
+			}
+
+			if r.Principal == "" {
+				continue
+			}
+
+			if principalType == "AWS" {
+				if policyEvaluationMatchAWSPrincipal(identifier, r.Principal) {
+					return true
+				}
+
+				continue
+			}
+
+			if identifier == r.Principal {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// policyEvaluationMatchAWSPrincipal returns whether an "AWS" principal in a policy matches a principal ARN.
+// An account ID or account root ARN matches every principal in the account.
+func policyEvaluationMatchAWSPrincipal(identifier, principal string) bool {
+	if identifier == principal {
+		return true
+	}
+
+	principalARN, err := arn.Parse(principal)
+
+	if err != nil {
+		return false
+	}
+
+	if isValidPolicyAWSPrincipal(identifier) && !arn.IsARN(identifier) {
+		return identifier == principalARN.AccountID
+	}
+
+	identifierARN, err := arn.Parse(identifier)
+
+	if err != nil {
+		return false
+	}
+
+	return identifierARN.Service == "iam" && identifierARN.Resource == "root" && identifierARN.AccountID == principalARN.AccountID
+}
+
+// parsePolicyEvaluationConditionOperator splits a condition operator such as "ForAnyValue:StringLikeIfExists" into its
+// set operator, base operator and whether the "IfExists" suffix is present.
+func parsePolicyEvaluationConditionOperator(operator string) (string, string, bool, error) {
+	setOperator, base, ok := strings.Cut(operator, ":")
+
+	if !ok {
+		setOperator, base = "", operator
+	} else if setOperator != "ForAllValues" && setOperator != "ForAnyValue" {
+		return "", "", false, fmt.Errorf("unsupported condition operator %q", operator)
+	}
+
+	ifExists := false
+	if base != "Null" {
+		base, ifExists = strings.CutSuffix(base, "IfExists")
+	}
+
+	if _, ok := policyEvaluationConditionOperators[base]; !ok {
+		return "", "", false, fmt.Errorf("unsupported condition operator %q", operator)
+	}
+
+	return setOperator, base, ifExists, nil
+}
+
+// policyEvaluationConditionOperator compares a value from the request context with a value from a policy.
+type policyEvaluationConditionOperator struct {
+	compare func(requestValue, policyValue string) bool
+	negated bool
+}
+
+var policyEvaluationConditionOperators = map[string]policyEvaluationConditionOperator{
+	"StringEquals":              {compare: policyEvaluationStringEquals},
+	"StringNotEquals":           {compare: policyEvaluationStringEquals, negated: true},
+	"StringEqualsIgnoreCase":    {compare: strings.EqualFold},
+	"StringNotEqualsIgnoreCase": {compare: strings.EqualFold, negated: true},
+	"StringLike":                {compare: policyEvaluationStringLike},
+	"StringNotLike":             {compare: policyEvaluationStringLike, negated: true},
+	"NumericEquals":             {compare: policyEvaluationNumeric(func(r, p float64) bool { return r == p })},
+	"NumericNotEquals":          {compare: policyEvaluationNumeric(func(r, p float64) bool { return r == p }), negated: true},
+	"NumericLessThan":           {compare: policyEvaluationNumeric(func(r, p float64) bool { return r < p })},
+	"NumericLessThanEquals":     {compare: policyEvaluationNumeric(func(r, p float64) bool { return r <= p })},
+	"NumericGreaterThan":        {compare: policyEvaluationNumeric(func(r, p float64) bool { return r > p })},
+	"NumericGreaterThanEquals":  {compare: policyEvaluationNumeric(func(r, p float64) bool { return r >= p })},
+	"DateEquals":                {compare: policyEvaluationDate(func(r, p time.Time) bool { return r.Equal(p) })},
+	"DateNotEquals":             {compare: policyEvaluationDate(func(r, p time.Time) bool { return r.Equal(p) }), negated: true},
+	"DateLessThan":              {compare: policyEvaluationDate(func(r, p time.Time) bool { return r.Before(p) })},
+	"DateLessThanEquals":        {compare: policyEvaluationDate(func(r, p time.Time) bool { return !r.After(p) })},
+	"DateGreaterThan":           {compare: policyEvaluationDate(func(r, p time.Time) bool { return r.After(p) })},
+	"DateGreaterThanEquals":     {compare: policyEvaluationDate(func(r, p time.Time) bool { return !r.Before(p) })},
+	"Bool":                      {compare: strings.EqualFold},
+	"BinaryEquals":              {compare: policyEvaluationStringEquals},
+	"IpAddress":                 {compare: policyEvaluationIPAddress},
+	"NotIpAddress":              {compare: policyEvaluationIPAddress, negated: true},
+	"ArnEquals":                 {compare: policyEvaluationStringLike},
+	"ArnLike":                   {compare: policyEvaluationStringLike},
+	"ArnNotEquals":              {compare: policyEvaluationStringLike, negated: true},
+	"ArnNotLike":                {compare: policyEvaluationStringLike, negated: true},
+	"Null":                      {},
+}
+
+// matchesCondition evaluates a single condition key of a condition operator against the request context.
+func (r *policyEvaluationRequest) matchesCondition(operator, key string, policyValues []string, missing map[string]struct{}) bool {
+	setOperator, base, ifExists, err := parsePolicyEvaluationConditionOperator(operator)
+
+	if err != nil {
+		return false
+	}
+
+	requestValues, present := r.Context[strings.ToLower(key)]
+
+	if base == "Null" {
+		for _, v := range policyValues {
+			if strings.EqualFold(v, strconv.FormatBool(!present)) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	op := policyEvaluationConditionOperators[base]
+
+	if !present {
+		if !ifExists && setOperator == "" {
+			missing[key] = struct{}{}
+		}
+
+		// A missing key matches IfExists and negated operators. For an empty set, ForAllValues is true
+		// and ForAnyValue is false, whether or not the operator is negated.
+		switch {
+		case ifExists:
+			return true
+		case setOperator == "ForAllValues":
+			return true
+		case setOperator == "ForAnyValue":
+			return false
+		default:
+			return op.negated
+		}
+	}
+
+	// matchesAny returns whether the request value matches any of the policy values.
+	matchesAny := func(requestValue string) bool {
+		for _, policyValue := range policyValues {
+			if op.compare(requestValue, policyValue) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	switch setOperator {
+	case "ForAllValues":
+		for _, v := range requestValues {
+			if matchesAny(v) == op.negated {
+				return false
+			}
+		}
+
+		return true
+	case "ForAnyValue":
+		for _, v := range requestValues {
+			if matchesAny(v) != op.negated {
+				return true
+			}
+		}
+
+		return false
+	default:
+		for _, v := range requestValues {
+			if matchesAny(v) {
+				return !op.negated
+			}
+		}
+
+		return op.negated
+	}
+}
+
+func policyEvaluationStringEquals(requestValue, policyValue string) bool {
+	return requestValue == policyValue
+}
+
+func policyEvaluationStringLike(requestValue, policyValue string) bool {
+	return policyEvaluationWildcardMatch(policyValue, requestValue, false)
+}
+
+func policyEvaluationNumeric(compare func(float64, float64) bool) func(string, string) bool {
+	return func(requestValue, policyValue string) bool {
+		r, err := strconv.ParseFloat(requestValue, 64)
+		if err != nil {
+			return false
+		}
+
+		p, err := strconv.ParseFloat(policyValue, 64)
+		if err != nil {
+			return false
+		}
+
+		return compare(r, p)
+	}
+}
+
+func policyEvaluationDate(compare func(time.Time, time.Time) bool) func(string, string) bool {
+	return func(requestValue, policyValue string) bool {
+		r, ok := parsePolicyEvaluationDate(requestValue)
+		if !ok {
+			return false
+		}
+
+		p, ok := parsePolicyEvaluationDate(policyValue)
+		if !ok {
+			return false
+		}
+
+		return compare(r, p)
+	}
+}
+
+// parsePolicyEvaluationDate parses an ISO 8601 date, with or without a time, or a number of seconds since the epoch.
+func parsePolicyEvaluationDate(s string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05Z0700", "2006-01-02T15:04Z07:00", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(v, 0), true
+	}
+
+	return time.Time{}, false
+}
+
+func policyEvaluationIPAddress(requestValue, policyValue string) bool {
+	ip := net.ParseIP(requestValue)
+	if ip == nil {
+		return false
+	}
+
+	if !strings.Contains(policyValue, "/") {
+		return ip.Equal(net.ParseIP(policyValue))
+	}
+
+	_, ipNet, err := net.ParseCIDR(policyValue)
+	if err != nil {
+		return false
+	}
+
+	return ipNet.Contains(ip)
+}
+
+func policyEvaluationMatchAny(patterns []string, value string, ignoreCase bool) bool {
+	for _, pattern := range patterns {
+		if policyEvaluationWildcardMatch(pattern, value, ignoreCase) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// policyEvaluationWildcardMatch returns whether a value matches a pattern in which "*" matches any sequence of characters
+// and "?" matches any single character.
+func policyEvaluationWildcardMatch(pattern, value string, ignoreCase bool) bool {
+	if ignoreCase {
+		pattern, value = strings.ToLower(pattern), strings.ToLower(value)
+	}
+
+	p, v := []rune(pattern), []rune(value)
+	pi, vi := 0, 0
+	star, match := -1, 0
+
+	for vi < len(v) {
+		switch {
+		case pi < len(p) && (p[pi] == '?' || p[pi] == v[vi]):
+			pi++
+			vi++
+		case pi < len(p) && p[pi] == '*':
+			star, match = pi, vi
+			pi++
+		case star >= 0:
+			pi = star + 1
+			match++
+			vi = match
+		default:
+			return false
+		}
+	}
+
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+
+	return pi == len(p)
+}
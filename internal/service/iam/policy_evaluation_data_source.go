@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_iam_policy_evaluation")
+func DataSourcePolicyEvaluation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePolicyEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			"identity_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+				Description: `Identity-based policies attached to the principal, e.g. from the aws_iam_policy_document data source.`,
+			},
+			"permissions_boundary_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				Description:  `Permissions boundary of the principal.`,
+			},
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  `ARN, account ID or service principal of the principal making the requests. Used to match the Principal element of resource-based policies and as the value of the aws:PrincipalArn and aws:PrincipalAccount context keys.`,
+			},
+			"request": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(policyEvaluationActionRegexp, `must be in the format "service:Action"`),
+							Description:  `Name of the action, like "s3:GetObject".`,
+						},
+						"context": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `The key name of the context entry, such as "aws:SourceIp".`,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+										Description: `One or more values of the context entry.`,
+									},
+								},
+							},
+							Description: `Context entries of the request, used to evaluate the Condition element of policies and policy variables.`,
+						},
+						"resource": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "*",
+							Description: `ARN of the resource that the action is performed on.`,
+						},
+					},
+				},
+				Description: `Requests to evaluate.`,
+			},
+			"resource_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+				Description: `Resource-based policies attached to the requested resources.`,
+			},
+			"service_control_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+				Description: `Service control policies that apply to the principal's account. Each policy must allow a request for it to be allowed, as if each was attached at a different level of the organization.`,
+			},
+
+			// Result Attributes
+			"all_allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `True if all of the results have decision "allowed", and false otherwise.`,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the action that this result describes.`,
+						},
+						"allowed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `True only if decision is "allowed".`,
+						},
+						"deciding_policy_index": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `Index of the deciding policy within the argument given by deciding_policy_type.`,
+						},
+						"deciding_policy_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Type of the policy that decided the result: "identity", "resource", "permissions_boundary" or "service_control". Empty if no policy allowed the request.`,
+						},
+						"deciding_statement_index": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `Index of the deciding statement within the deciding policy, or -1 if no statement decided the result.`,
+						},
+						"deciding_statement_sid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Sid of the deciding statement.`,
+						},
+						"decision": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The decision: "allowed", "explicitDeny", or "implicitDeny".`,
+						},
+						"missing_context_keys": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: `Context keys that were referenced by the policies but not included in the request.`,
+						},
+						"resource": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `ARN of the resource that the action was evaluated against.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePolicyEvaluationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	policies := &policyEvaluationPolicies{}
+
+	for _, v := range []struct {
+		key        string
+		policyType string
+		target     *[]*policyEvaluationPolicy
+	}{
+		{"identity_policies_json", policyEvaluationPolicyTypeIdentity, &policies.Identity},
+		{"resource_policies_json", policyEvaluationPolicyTypeResource, &policies.Resource},
+		{"service_control_policies_json", policyEvaluationPolicyTypeServiceControl, &policies.ServiceControl},
+	} {
+		for i, document := range d.Get(v.key).([]interface{}) {
+			document, _ := document.(string)
+			policy, err := parsePolicyEvaluationPolicy(v.policyType, i, document)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "parsing %s (%d): %s", v.key, i, err)
+			}
+
+			*v.target = append(*v.target, policy)
+		}
+	}
+
+	if v, ok := d.GetOk("permissions_boundary_json"); ok {
+		policy, err := parsePolicyEvaluationPolicy(policyEvaluationPolicyTypePermissionsBoundary, 0, v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "parsing permissions_boundary_json: %s", err)
+		}
+
+		policies.PermissionsBoundary = append(policies.PermissionsBoundary, policy)
+	}
+
+	principal := d.Get("principal_arn").(string)
+	allAllowed := true
+	var results []interface{}
+
+	for _, tfMapRaw := range d.Get("request").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		request := expandPolicyEvaluationRequest(tfMap, principal)
+		result := evaluatePolicies(request, policies)
+
+		allAllowed = allAllowed && result.Decision == policyEvaluationDecisionAllowed
+		results = append(results, flattenPolicyEvaluationResult(request, result))
+	}
+
+	d.SetId("-")
+	d.Set("all_allowed", allAllowed)
+	if err := d.Set("results", results); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting results: %s", err)
+	}
+
+	return diags
+}
+
+func expandPolicyEvaluationRequest(tfMap map[string]interface{}, principal string) *policyEvaluationRequest {
+	request := &policyEvaluationRequest{
+		Action:    tfMap["action"].(string),
+		Resource:  tfMap["resource"].(string),
+		Principal: principal,
+		Context:   make(map[string][]string),
+	}
+
+	if principal != "" {
+		request.Context["aws:principalarn"] = []string{principal}
+
+		if v, err := arn.Parse(principal); err == nil {
+			request.Context["aws:principalaccount"] = []string{v.AccountID}
+		} else if isValidPolicyAWSPrincipal(principal) {
+			request.Context["aws:principalaccount"] = []string{principal}
+		}
+	}
+
+	for _, tfMapRaw := range tfMap["context"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var values []string
+		for _, v := range tfMap["values"].([]interface{}) {
+			v, _ := v.(string)
+			values = append(values, v)
+		}
+
+		request.Context[strings.ToLower(tfMap["key"].(string))] = values
+	}
+
+	return request
+}
+
+func flattenPolicyEvaluationResult(request *policyEvaluationRequest, result *policyEvaluationResult) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"action":                   request.Action,
+		"allowed":                  result.Decision == policyEvaluationDecisionAllowed,
+		"deciding_policy_index":    result.PolicyIndex,
+		"deciding_policy_type":     result.PolicyType,
+		"deciding_statement_index": -1,
+		"decision":                 result.Decision,
+		"missing_context_keys":     result.MissingContextKeys,
+		"resource":                 request.Resource,
+	}
+
+	if v := result.Statement; v != nil {
+		tfMap["deciding_statement_index"] = v.Index
+		tfMap["deciding_statement_sid"] = v.Sid
+	}
+
+	return tfMap
+}
@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPolicyEvaluationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "4"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.action", "s3:GetObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.deciding_policy_index", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.deciding_policy_type", "identity"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.deciding_statement_index", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.deciding_statement_sid", "ReadObjects"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.missing_context_keys.#", "0"),
+					acctest.CheckResourceAttrGlobalARNNoAccount(dataSourceName, "results.0.resource", "s3", "example/data.csv"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.action", "s3:DeleteObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.deciding_policy_type", "identity"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.deciding_statement_sid", "DenyDelete"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.decision", "explicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.action", "s3:PutObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.deciding_policy_type", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.deciding_statement_index", "-1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.decision", "implicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.missing_context_keys.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.missing_context_keys.0", "aws:SourceVpce"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.3.action", "s3:PutObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.3.allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.3.deciding_policy_type", "resource"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.3.deciding_statement_sid", "WriteFromEndpoint"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.3.decision", "allowed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyEvaluationDataSource_serviceControlPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig_serviceControlPolicy,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.decision", "explicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.deciding_policy_type", "service_control"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.deciding_statement_sid", "DenyOtherRegions"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyEvaluationDataSource_invalidPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyEvaluationDataSourceConfig_invalidPolicy,
+				ExpectError: regexp.MustCompile(`unsupported condition operator "StringSorta"`),
+			},
+		},
+	})
+}
+
+const testAccPolicyEvaluationDataSourceConfig_basic = `
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "identity" {
+  statement {
+    sid       = "ReadObjects"
+    actions   = ["s3:GetObject", "s3:ListBucket"]
+    resources = ["arn:${data.aws_partition.current.partition}:s3:::example", "arn:${data.aws_partition.current.partition}:s3:::example/*"]
+  }
+
+  statement {
+    sid       = "DenyDelete"
+    effect    = "Deny"
+    actions   = ["s3:DeleteObject"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "resource" {
+  statement {
+    sid       = "WriteFromEndpoint"
+    actions   = ["s3:PutObject"]
+    resources = ["arn:${data.aws_partition.current.partition}:s3:::example/*"]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["123456789012"]
+    }
+
+    condition {
+      test     = "StringEquals"
+      variable = "aws:SourceVpce"
+      values   = ["vpce-1a2b3c4d"]
+    }
+  }
+}
+
+data "aws_iam_policy_evaluation" "test" {
+  identity_policies_json = [data.aws_iam_policy_document.identity.json]
+  resource_policies_json = [data.aws_iam_policy_document.resource.json]
+  principal_arn          = "arn:${data.aws_partition.current.partition}:iam::123456789012:role/example"
+
+  request {
+    action   = "s3:GetObject"
+    resource = "arn:${data.aws_partition.current.partition}:s3:::example/data.csv"
+  }
+
+  request {
+    action   = "s3:DeleteObject"
+    resource = "arn:${data.aws_partition.current.partition}:s3:::example/data.csv"
+  }
+
+  request {
+    action   = "s3:PutObject"
+    resource = "arn:${data.aws_partition.current.partition}:s3:::example/data.csv"
+  }
+
+  request {
+    action   = "s3:PutObject"
+    resource = "arn:${data.aws_partition.current.partition}:s3:::example/data.csv"
+
+    context {
+      key    = "aws:SourceVpce"
+      values = ["vpce-1a2b3c4d"]
+    }
+  }
+}
+`
+
+const testAccPolicyEvaluationDataSourceConfig_serviceControlPolicy = `
+data "aws_iam_policy_document" "identity" {
+  statement {
+    actions   = ["ec2:*"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "scp" {
+  statement {
+    sid       = "AllowAll"
+    actions   = ["*"]
+    resources = ["*"]
+  }
+
+  statement {
+    sid       = "DenyOtherRegions"
+    effect    = "Deny"
+    actions   = ["*"]
+    resources = ["*"]
+
+    condition {
+      test     = "StringNotEquals"
+      variable = "aws:RequestedRegion"
+      values   = ["us-east-1", "us-west-2"]
+    }
+  }
+}
+
+data "aws_iam_policy_evaluation" "test" {
+  identity_policies_json        = [data.aws_iam_policy_document.identity.json]
+  service_control_policies_json = [data.aws_iam_policy_document.scp.json]
+
+  request {
+    action = "ec2:RunInstances"
+
+    context {
+      key    = "aws:RequestedRegion"
+      values = ["us-west-2"]
+    }
+  }
+
+  request {
+    action = "ec2:RunInstances"
+
+    context {
+      key    = "aws:RequestedRegion"
+      values = ["eu-west-1"]
+    }
+  }
+}
+`
+
+const testAccPolicyEvaluationDataSourceConfig_invalidPolicy = `
+data "aws_iam_policy_evaluation" "test" {
+  identity_policies_json = [jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Action    = "s3:GetObject"
+      Resource  = "*"
+      Condition = { StringSorta = { "aws:username" = "alice" } }
+    }]
+  })]
+
+  request {
+    action = "s3:GetObject"
+  }
+}
+`
@@ -0,0 +1,489 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEvaluatePolicies(t *testing.T) {
+	t.Parallel()
+
+	const (
+		bucketPolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "AllowRead",
+      "Effect": "Allow",
+      "Action": ["s3:GetObject", "s3:List*"],
+      "Resource": ["arn:aws:s3:::example", "arn:aws:s3:::example/*"]
+    },
+    {
+      "Sid": "DenyDelete",
+      "Effect": "Deny",
+      "Action": "s3:DeleteObject",
+      "Resource": "*"
+    },
+    {
+      "Sid": "AllowHome",
+      "Effect": "Allow",
+      "Action": "s3:PutObject",
+      "Resource": "arn:aws:s3:::example/home/${aws:username}/*"
+    },
+    {
+      "Sid": "AllowFromNetwork",
+      "Effect": "Allow",
+      "NotAction": ["s3:*Object", "s3:List*"],
+      "Resource": "*",
+      "Condition": {
+        "IpAddress": {"aws:SourceIp": "10.0.0.0/8"},
+        "NumericLessThanEquals": {"s3:max-keys": 10}
+      }
+    }
+  ]
+}`
+		tlsPolicy = `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Sid": "DenyInsecureTransport",
+    "Effect": "Deny",
+    "Action": "*",
+    "Resource": "*",
+    "Condition": {"Bool": {"aws:SecureTransport": false}}
+  }
+}`
+		resourcePolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "AllowAccount",
+      "Effect": "Allow",
+      "Principal": {"AWS": "123456789012"},
+      "Action": "sqs:SendMessage",
+      "Resource": "*"
+    },
+    {
+      "Sid": "AllowService",
+      "Effect": "Allow",
+      "Principal": {"Service": "sns.amazonaws.com"},
+      "Action": "sqs:SendMessage",
+      "Resource": "*",
+      "Condition": {"ArnLike": {"aws:SourceArn": "arn:aws:sns:*:123456789012:*"}}
+    }
+  ]
+}`
+		boundaryPolicy = `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Action": ["s3:*", "sqs:*"],
+    "Resource": "*"
+  }
+}`
+		scpPolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "AllowAll",
+      "Effect": "Allow",
+      "Action": "*",
+      "Resource": "*"
+    },
+    {
+      "Sid": "DenyOtherRegions",
+      "Effect": "Deny",
+      "Action": "*",
+      "Resource": "*",
+      "Condition": {"StringNotEquals": {"aws:RequestedRegion": ["us-east-1", "us-west-2"]}}
+    }
+  ]
+}`
+	)
+
+	const principal = "arn:aws:iam::123456789012:user/alice" //lintignore:AWSAT005
+
+	parse := func(t *testing.T, policyType string, documents ...string) []*policyEvaluationPolicy {
+		t.Helper()
+
+		var policies []*policyEvaluationPolicy
+
+		for i, document := range documents {
+			policy, err := parsePolicyEvaluationPolicy(policyType, i, document)
+
+			if err != nil {
+				t.Fatalf("parsing policy: %s", err)
+			}
+
+			policies = append(policies, policy)
+		}
+
+		return policies
+	}
+
+	type expected struct {
+		decision   string
+		policyType string
+		sid        string
+		missing    []string
+	}
+
+	testCases := map[string]struct {
+		identity []string
+		resource []string
+		boundary []string
+		scp      []string
+		request  policyEvaluationRequest
+		expected expected
+	}{
+		"allowed": {
+			identity: []string{bucketPolicy},
+			request:  policyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/a.txt"},
+			expected: expected{policyEvaluationDecisionAllowed, policyEvaluationPolicyTypeIdentity, "AllowRead", nil},
+		},
+		"action case insensitive wildcard": {
+			identity: []string{bucketPolicy},
+			request:  policyEvaluationRequest{Action: "S3:LISTBUCKET", Resource: "arn:aws:s3:::example"},
+			expected: expected{policyEvaluationDecisionAllowed, policyEvaluationPolicyTypeIdentity, "AllowRead", nil},
+		},
+		"resource case sensitive": {
+			identity: []string{bucketPolicy},
+			request:  policyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::EXAMPLE/a.txt"},
+			expected: expected{policyEvaluationDecisionImplicitDeny, "", "", nil},
+		},
+		"explicit deny": {
+			identity: []string{bucketPolicy},
+			request:  policyEvaluationRequest{Action: "s3:DeleteObject", Resource: "arn:aws:s3:::example/a.txt"},
+			expected: expected{policyEvaluationDecisionExplicitDeny, policyEvaluationPolicyTypeIdentity, "DenyDelete", nil},
+		},
+		"policy variable": {
+			identity: []string{bucketPolicy},
+			request: policyEvaluationRequest{Action: "s3:PutObject", Resource: "arn:aws:s3:::example/home/alice/a.txt", Context: map[string][]string{
+				"aws:username": {"alice"},
+			}},
+			expected: expected{policyEvaluationDecisionAllowed, policyEvaluationPolicyTypeIdentity, "AllowHome", nil},
+		},
+		"policy variable other user": {
+			identity: []string{bucketPolicy},
+			request: policyEvaluationRequest{Action: "s3:PutObject", Resource: "arn:aws:s3:::example/home/bob/a.txt", Context: map[string][]string{
+				"aws:username": {"alice"},
+			}},
+			expected: expected{policyEvaluationDecisionImplicitDeny, "", "", nil},
+		},
+		"conditions met": {
+			identity: []string{bucketPolicy},
+			request: policyEvaluationRequest{Action: "s3:GetBucketPolicy", Resource: "arn:aws:s3:::example", Context: map[string][]string{
+				"aws:sourceip": {"10.1.2.3"},
+				"s3:max-keys":  {"5"},
+			}},
+			expected: expected{policyEvaluationDecisionAllowed, policyEvaluationPolicyTypeIdentity, "AllowFromNetwork", nil},
+		},
+		"condition not met": {
+			identity: []string{bucketPolicy},
+			request: policyEvaluationRequest{Action: "s3:GetBucketPolicy", Resource: "arn:aws:s3:::example", Context: map[string][]string{
+				"aws:sourceip": {"192.168.0.1"},
+				"s3:max-keys":  {"5"},
+			}},
+			expected: expected{policyEvaluationDecisionImplicitDeny, "", "", nil},
+		},
+		"condition key missing": {
+			identity: []string{bucketPolicy},
+			request:  policyEvaluationRequest{Action: "s3:GetBucketPolicy", Resource: "arn:aws:s3:::example"},
+			expected: expected{policyEvaluationDecisionImplicitDeny, "", "", []string{"aws:SourceIp"}},
+		},
+		"bool deny": {
+			identity: []string{bucketPolicy, tlsPolicy},
+			request: policyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/a.txt", Context: map[string][]string{
+				"aws:securetransport": {"false"},
+			}},
+			expected: expected{policyEvaluationDecisionExplicitDeny, policyEvaluationPolicyTypeIdentity, "DenyInsecureTransport", nil},
+		},
+		"resource policy account principal": {
+			resource: []string{resourcePolicy},
+			request:  policyEvaluationRequest{Action: "sqs:SendMessage", Resource: "arn:aws:sqs:us-west-2:123456789012:queue", Principal: principal},
+			expected: expected{policyEvaluationDecisionAllowed, policyEvaluationPolicyTypeResource, "AllowAccount", nil},
+		},
+		"resource policy other account": {
+			resource: []string{resourcePolicy},
+			request:  policyEvaluationRequest{Action: "sqs:SendMessage", Resource: "arn:aws:sqs:us-west-2:123456789012:queue", Principal: "arn:aws:iam::210987654321:user/bob"}, //lintignore:AWSAT005
+			expected: expected{policyEvaluationDecisionImplicitDeny, "", "", nil},
+		},
+		"resource policy service principal": {
+			resource: []string{resourcePolicy},
+			request: policyEvaluationRequest{Action: "sqs:SendMessage", Resource: "arn:aws:sqs:us-west-2:123456789012:queue", Principal: "sns.amazonaws.com", Context: map[string][]string{
+				"aws:sourcearn": {"arn:aws:sns:us-west-2:123456789012:topic"}, //lintignore:AWSAT003,AWSAT005
+			}},
+			expected: expected{policyEvaluationDecisionAllowed, policyEvaluationPolicyTypeResource, "AllowService", nil},
+		},
+		"permissions boundary": {
+			identity: []string{bucketPolicy, `{"Statement": {"Effect": "Allow", "Action": "ec2:*", "Resource": "*"}}`},
+			boundary: []string{boundaryPolicy},
+			request:  policyEvaluationRequest{Action: "ec2:RunInstances", Resource: "*"},
+			expected: expected{policyEvaluationDecisionImplicitDeny, policyEvaluationPolicyTypePermissionsBoundary, "", nil},
+		},
+		"permissions boundary allows": {
+			identity: []string{bucketPolicy},
+			boundary: []string{boundaryPolicy},
+			request:  policyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/a.txt"},
+			expected: expected{policyEvaluationDecisionAllowed, policyEvaluationPolicyTypeIdentity, "AllowRead", nil},
+		},
+		"service control policy negated operator": {
+			identity: []string{bucketPolicy},
+			scp:      []string{scpPolicy},
+			request: policyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/a.txt", Context: map[string][]string{
+				"aws:requestedregion": {"eu-west-1"},
+			}},
+			expected: expected{policyEvaluationDecisionExplicitDeny, policyEvaluationPolicyTypeServiceControl, "DenyOtherRegions", nil},
+		},
+		"service control policy missing key": {
+			identity: []string{bucketPolicy},
+			scp:      []string{scpPolicy},
+			request:  policyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/a.txt"},
+			expected: expected{policyEvaluationDecisionExplicitDeny, policyEvaluationPolicyTypeServiceControl, "DenyOtherRegions", []string{"aws:RequestedRegion"}},
+		},
+		"service control policy allows": {
+			identity: []string{bucketPolicy},
+			scp:      []string{scpPolicy},
+			request: policyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/a.txt", Context: map[string][]string{
+				"aws:requestedregion": {"us-west-2"},
+			}},
+			expected: expected{policyEvaluationDecisionAllowed, policyEvaluationPolicyTypeIdentity, "AllowRead", nil},
+		},
+		"service control policy implicit deny": {
+			identity: []string{bucketPolicy},
+			scp:      []string{`{"Statement": {"Effect": "Allow", "Action": "ec2:*", "Resource": "*"}}`},
+			request:  policyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/a.txt"},
+			expected: expected{policyEvaluationDecisionImplicitDeny, policyEvaluationPolicyTypeServiceControl, "", nil},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			policies := &policyEvaluationPolicies{
+				Identity:            parse(t, policyEvaluationPolicyTypeIdentity, testCase.identity...),
+				Resource:            parse(t, policyEvaluationPolicyTypeResource, testCase.resource...),
+				PermissionsBoundary: parse(t, policyEvaluationPolicyTypePermissionsBoundary, testCase.boundary...),
+				ServiceControl:      parse(t, policyEvaluationPolicyTypeServiceControl, testCase.scp...),
+			}
+
+			result := evaluatePolicies(&testCase.request, policies)
+
+			got := expected{
+				decision:   result.Decision,
+				policyType: result.PolicyType,
+				missing:    result.MissingContextKeys,
+			}
+			if result.Statement != nil {
+				got.sid = result.Statement.Sid
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, cmp.AllowUnexported(expected{})); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyEvaluationConditionOperators(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		operator string
+		key      string
+		values   []string
+		context  map[string][]string
+		expected bool
+	}{
+		"StringLike": {
+			operator: "StringLike",
+			key:      "s3:prefix",
+			values:   []string{"home/*"},
+			context:  map[string][]string{"s3:prefix": {"home/alice"}},
+			expected: true,
+		},
+		"StringEqualsIgnoreCase": {
+			operator: "StringEqualsIgnoreCase",
+			key:      "aws:PrincipalTag/team",
+			values:   []string{"Platform"},
+			context:  map[string][]string{"aws:principaltag/team": {"platform"}},
+			expected: true,
+		},
+		"StringEqualsIfExists missing": {
+			operator: "StringEqualsIfExists",
+			key:      "ec2:InstanceType",
+			values:   []string{"t3.micro"},
+			expected: true,
+		},
+		"StringEqualsIfExists present": {
+			operator: "StringEqualsIfExists",
+			key:      "ec2:InstanceType",
+			values:   []string{"t3.micro"},
+			context:  map[string][]string{"ec2:instancetype": {"m5.large"}},
+			expected: false,
+		},
+		"Null true": {
+			operator: "Null",
+			key:      "aws:TokenIssueTime",
+			values:   []string{"true"},
+			expected: true,
+		},
+		"Null false": {
+			operator: "Null",
+			key:      "aws:TokenIssueTime",
+			values:   []string{"false"},
+			expected: false,
+		},
+		"ForAllValues subset": {
+			operator: "ForAllValues:StringEquals",
+			key:      "aws:TagKeys",
+			values:   []string{"env", "team"},
+			context:  map[string][]string{"aws:tagkeys": {"env"}},
+			expected: true,
+		},
+		"ForAllValues not subset": {
+			operator: "ForAllValues:StringEquals",
+			key:      "aws:TagKeys",
+			values:   []string{"env", "team"},
+			context:  map[string][]string{"aws:tagkeys": {"env", "owner"}},
+			expected: false,
+		},
+		"ForAllValues missing": {
+			operator: "ForAllValues:StringEquals",
+			key:      "aws:TagKeys",
+			values:   []string{"env"},
+			expected: true,
+		},
+		"ForAnyValue": {
+			operator: "ForAnyValue:StringLike",
+			key:      "aws:TagKeys",
+			values:   []string{"cost*"},
+			context:  map[string][]string{"aws:tagkeys": {"env", "costcenter"}},
+			expected: true,
+		},
+		"ForAnyValue missing": {
+			operator: "ForAnyValue:StringLike",
+			key:      "aws:TagKeys",
+			values:   []string{"cost*"},
+			expected: false,
+		},
+		"ForAnyValue negated missing": {
+			operator: "ForAnyValue:StringNotLike",
+			key:      "aws:TagKeys",
+			values:   []string{"cost*"},
+			expected: false,
+		},
+		"ForAllValues negated missing": {
+			operator: "ForAllValues:StringNotEquals",
+			key:      "aws:TagKeys",
+			values:   []string{"env"},
+			expected: true,
+		},
+		"StringNotEquals missing": {
+			operator: "StringNotEquals",
+			key:      "aws:PrincipalTag/team",
+			values:   []string{"platform"},
+			expected: true,
+		},
+		"DateLessThan": {
+			operator: "DateLessThan",
+			key:      "aws:CurrentTime",
+			values:   []string{"2024-01-01T00:00:00Z"},
+			context:  map[string][]string{"aws:currenttime": {"2023-06-01T12:00:00Z"}},
+			expected: true,
+		},
+		"DateGreaterThan epoch": {
+			operator: "DateGreaterThan",
+			key:      "aws:EpochTime",
+			values:   []string{"2024-01-01"},
+			context:  map[string][]string{"aws:epochtime": {"1685620800"}},
+			expected: false,
+		},
+		"NotIpAddress": {
+			operator: "NotIpAddress",
+			key:      "aws:SourceIp",
+			values:   []string{"203.0.113.0/24", "198.51.100.7"},
+			context:  map[string][]string{"aws:sourceip": {"198.51.100.7"}},
+			expected: false,
+		},
+		"ArnNotLike": {
+			operator: "ArnNotLike",
+			key:      "aws:PrincipalArn",
+			values:   []string{"arn:aws:iam::*:role/admin-*"},                                               //lintignore:AWSAT005
+			context:  map[string][]string{"aws:principalarn": {"arn:aws:iam::123456789012:role/admin-ops"}}, //lintignore:AWSAT005
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := &policyEvaluationRequest{Context: testCase.context}
+
+			if got := request.matchesCondition(testCase.operator, testCase.key, testCase.values, map[string]struct{}{}); got != testCase.expected {
+				t.Errorf("matchesCondition = %t, want %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestParsePolicyEvaluationPolicy_errors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"invalid JSON":       `{`,
+		"invalid Effect":     `{"Statement": {"Effect": "Maybe", "Action": "*", "Resource": "*"}}`,
+		"no Action":          `{"Statement": {"Effect": "Allow", "Resource": "*"}}`,
+		"invalid Principal":  `{"Statement": {"Effect": "Allow", "Principal": "alice", "Action": "*"}}`,
+		"invalid operator":   `{"Statement": {"Effect": "Allow", "Action": "*", "Condition": {"StringSorta": {"aws:username": "alice"}}}}`,
+		"invalid set prefix": `{"Statement": {"Effect": "Allow", "Action": "*", "Condition": {"ForSomeValues:StringEquals": {"aws:TagKeys": "env"}}}}`,
+	}
+
+	for name, document := range testCases {
+		document := document
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := parsePolicyEvaluationPolicy(policyEvaluationPolicyTypeIdentity, 0, document); err == nil {
+				t.Error("expected error, got none")
+			}
+		})
+	}
+}
+
+func TestPolicyEvaluationWildcardMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern    string
+		value      string
+		ignoreCase bool
+		expected   bool
+	}{
+		{"*", "", false, true},
+		{"*", "anything", false, true},
+		{"s3:Get*", "s3:GetObject", false, true},
+		{"s3:Get*", "s3:getobject", false, false},
+		{"s3:Get*", "s3:getobject", true, true},
+		{"arn:aws:s3:::bucket/*/a?c", "arn:aws:s3:::bucket/x/y/abc", false, true},
+		{"arn:aws:s3:::bucket/*/a?c", "arn:aws:s3:::bucket/x/y/abbc", false, false},
+		{"a*b*c", "aXXbYYc", false, true},
+		{"a*b*c", "aXXbYY", false, false},
+	}
+
+	for _, testCase := range testCases {
+		if got := policyEvaluationWildcardMatch(testCase.pattern, testCase.value, testCase.ignoreCase); got != testCase.expected {
+			t.Errorf("policyEvaluationWildcardMatch(%q, %q, %t) = %t, want %t", testCase.pattern, testCase.value, testCase.ignoreCase, got, testCase.expected)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"regexp"
 	"sort"
 	"strconv"
+	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/jmespath/go-jmespath"
@@ -82,6 +83,175 @@ func (s *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
 	}
 }
 
+// CollapseActions replaces the actions in each statement's Action element that match one of the specified wildcard
+// patterns, such as "s3:Get*", with the pattern.
+// This grants (or denies) every action that matches the pattern, not just the replaced actions.
+func (s *IAMPolicyDoc) CollapseActions(patterns []string) {
+	for _, stmt := range s.Statements {
+		actions := policyStatementStringList(stmt.Actions)
+		if len(actions) == 0 {
+			continue
+		}
+
+		var collapsed []string
+		for _, action := range actions {
+			replaced := false
+			for _, pattern := range patterns {
+				if policyEvaluationWildcardMatch(pattern, action, true) {
+					collapsed = append(collapsed, pattern)
+					replaced = true
+					break
+				}
+			}
+			if !replaced {
+				collapsed = append(collapsed, action)
+			}
+		}
+
+		stmt.Actions = policyEncodeStringList(collapsed)
+	}
+}
+
+// MergeStatements merges statements without a Sid that have the same Effect, Principal, NotPrincipal, NotAction,
+// NotResource and Condition elements and either the same resources, in which case their actions are combined, or the
+// same actions, in which case their resources are combined. Actions are only combined if both statements have an
+// Action element, and resources only if both statements have a Resource element.
+// The merged statement takes the place of the first of the statements.
+func (s *IAMPolicyDoc) MergeStatements() error {
+	for _, sameResources := range []bool{true, false} {
+		var merged []*IAMPolicyStatement
+		keys := make(map[string]*IAMPolicyStatement)
+
+		for _, stmt := range s.Statements {
+			if stmt.Sid != "" || (sameResources && stmt.Actions == nil) || (!sameResources && stmt.Resources == nil) {
+				merged = append(merged, stmt)
+				continue
+			}
+
+			key, err := policyStatementMergeKey(stmt, sameResources)
+			if err != nil {
+				return err
+			}
+
+			existing, ok := keys[key]
+			if !ok {
+				keys[key] = stmt
+				merged = append(merged, stmt)
+				continue
+			}
+
+			if sameResources {
+				existing.Actions = policyEncodeStringList(append(policyStatementStringList(existing.Actions), policyStatementStringList(stmt.Actions)...))
+			} else {
+				existing.Resources = policyEncodeStringList(append(policyStatementStringList(existing.Resources), policyStatementStringList(stmt.Resources)...))
+			}
+		}
+
+		s.Statements = merged
+	}
+
+	return nil
+}
+
+// policyStatementMergeKey returns a key that is the same for statements that can be merged.
+func policyStatementMergeKey(stmt *IAMPolicyStatement, sameResources bool) (string, error) {
+	key := struct {
+		Effect        string
+		Elements      []string
+		NotActions    []string                       `json:",omitempty"`
+		NotResources  []string                       `json:",omitempty"`
+		Principals    IAMPolicyStatementPrincipalSet `json:",omitempty"`
+		NotPrincipals IAMPolicyStatementPrincipalSet `json:",omitempty"`
+		Conditions    IAMPolicyStatementConditionSet `json:",omitempty"`
+	}{
+		Effect:        stmt.Effect,
+		NotActions:    policyEncodeStringSlice(policyStatementStringList(stmt.NotActions)),
+		NotResources:  policyEncodeStringSlice(policyStatementStringList(stmt.NotResources)),
+		Principals:    stmt.Principals,
+		NotPrincipals: stmt.NotPrincipals,
+		Conditions:    stmt.Conditions,
+	}
+
+	if sameResources {
+		key.Elements = policyEncodeStringSlice(policyStatementStringList(stmt.Resources))
+	} else {
+		key.Elements = policyEncodeStringSlice(policyStatementStringList(stmt.Actions))
+	}
+
+	b, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// Chunks splits the document's statements into documents whose JSON representation, excluding whitespace, has at most
+// maxSize characters. Each document has the same Version and Id as the original. A statement that is too large to fit
+// in a document of maxSize characters is placed in a document of its own, and its index is returned in oversized.
+func (s *IAMPolicyDoc) Chunks(maxSize int) (chunks []*IAMPolicyDoc, oversized []int, err error) {
+	newChunk := func(stmt *IAMPolicyStatement) *IAMPolicyDoc {
+		return &IAMPolicyDoc{
+			Version:    s.Version,
+			Id:         s.Id,
+			Statements: []*IAMPolicyStatement{stmt},
+		}
+	}
+
+	var chunk *IAMPolicyDoc
+
+	for i, stmt := range s.Statements {
+		if chunk != nil {
+			chunk.Statements = append(chunk.Statements, stmt)
+
+			size, err := chunk.size()
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if size <= maxSize {
+				continue
+			}
+
+			chunk.Statements = chunk.Statements[:len(chunk.Statements)-1]
+		}
+
+		chunk = newChunk(stmt)
+		chunks = append(chunks, chunk)
+
+		size, err := chunk.size()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if size > maxSize {
+			oversized = append(oversized, i)
+			// No other statement can be added to the chunk.
+			chunk = nil
+		}
+	}
+
+	if len(chunks) == 0 {
+		chunks = append(chunks, &IAMPolicyDoc{
+			Version: s.Version,
+			Id:      s.Id,
+		})
+	}
+
+	return chunks, oversized, nil
+}
+
+// size returns the number of characters in the document's JSON representation, excluding whitespace, which is how
+// IAM measures the size of policies.
+func (s *IAMPolicyDoc) size() (int, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return 0, err
+	}
+
+	return utf8.RuneCount(b), nil
+}
+
 func (ps IAMPolicyStatementPrincipalSet) MarshalJSON() ([]byte, error) {
 	raw := map[string]interface{}{}
 
@@ -235,6 +405,55 @@ func policyDecodeConfigStringList(lI []interface{}) interface{} {
 	return ret
 }
 
+// policyStatementStringList returns the values of a statement element that may be a string or a list of strings.
+func policyStatementStringList(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				values = append(values, v)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// policyEncodeStringList returns the unique values, sorted as by policyDecodeConfigStringList, as a string if there is
+// only one value.
+func policyEncodeStringList(values []string) interface{} {
+	values = policyEncodeStringSlice(values)
+
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	return values
+}
+
+func policyEncodeStringSlice(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	out := make([]string, 0, len(values))
+
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+
+	sort.Sort(sort.Reverse(sort.StringSlice(out)))
+
+	return out
+}
+
 // PolicyHasValidAWSPrincipals validates that the Principals in an IAM Policy are valid
 // Assumes that non-"AWS" Principals are valid
 // The value can be a single string or a slice of strings
@@ -5,6 +5,7 @@ package iam
 
 import (
 	"encoding/json"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -265,3 +266,208 @@ func TestIsValidAWSPrincipal(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 		})
 	}
 }
+
+func TestIAMPolicyDocMergeStatements(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testcases := map[string]struct {
+		json     string
+		expected string
+	}{
+		"same_resources": {
+			json:     `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"*"}]}`,
+			expected: `{"Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"*"}]}`,
+		},
+		"same_actions": {
+			json:     `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/*"},{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::b/*"}]}`, // lintignore:AWSAT005
+			expected: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":["arn:aws:s3:::b/*","arn:aws:s3:::a/*"]}]}`,                                                     // lintignore:AWSAT005
+		},
+		"different_actions_and_resources": {
+			json:     `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/*"},{"Effect":"Allow","Action":"s3:PutObject","Resource":"arn:aws:s3:::b/*"}]}`, // lintignore:AWSAT005
+			expected: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/*"},{"Effect":"Allow","Action":"s3:PutObject","Resource":"arn:aws:s3:::b/*"}]}`, // lintignore:AWSAT005
+		},
+		"different_effects": {
+			json:     `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"s3:PutObject","Resource":"*"}]}`,
+			expected: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"s3:PutObject","Resource":"*"}]}`,
+		},
+		"different_conditions": {
+			json:     `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"true"}}},{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+			expected: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":["true"]}}},{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+		},
+		"sid_and_not_action": {
+			json:     `{"Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","NotAction":"s3:PutObject","Resource":"*"},{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+			expected: `{"Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","NotAction":"s3:PutObject","Resource":"*"},{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+		},
+		"same_not_resources": {
+			json:     `{"Statement":[{"Effect":"Deny","Action":"s3:GetObject","NotResource":"arn:aws:s3:::a/*"},{"Effect":"Deny","Action":"s3:PutObject","NotResource":"arn:aws:s3:::a/*"}]}`, // lintignore:AWSAT005
+			expected: `{"Statement":[{"Effect":"Deny","Action":["s3:PutObject","s3:GetObject"],"NotResource":"arn:aws:s3:::a/*"}]}`,                                                           // lintignore:AWSAT005
+		},
+		"different_not_resources": {
+			json:     `{"Statement":[{"Effect":"Deny","Action":"s3:GetObject","NotResource":"arn:aws:s3:::a/*"},{"Effect":"Deny","Action":"s3:GetObject","NotResource":"arn:aws:s3:::b/*"}]}`, // lintignore:AWSAT005
+			expected: `{"Statement":[{"Effect":"Deny","Action":"s3:GetObject","NotResource":"arn:aws:s3:::a/*"},{"Effect":"Deny","Action":"s3:GetObject","NotResource":"arn:aws:s3:::b/*"}]}`, // lintignore:AWSAT005
+		},
+		"not_resource_and_resource": {
+			json:     `{"Statement":[{"Effect":"Deny","Action":"s3:GetObject","NotResource":"arn:aws:s3:::a/*"},{"Effect":"Deny","Action":"s3:GetObject","Resource":"arn:aws:s3:::b/*"}]}`, // lintignore:AWSAT005
+			expected: `{"Statement":[{"Effect":"Deny","Action":"s3:GetObject","NotResource":"arn:aws:s3:::a/*"},{"Effect":"Deny","Action":"s3:GetObject","Resource":"arn:aws:s3:::b/*"}]}`, // lintignore:AWSAT005
+		},
+		"same_not_actions": {
+			json:     `{"Statement":[{"Effect":"Deny","NotAction":"s3:GetObject","Resource":"arn:aws:s3:::a/*"},{"Effect":"Deny","NotAction":"s3:GetObject","Resource":"arn:aws:s3:::b/*"}]}`, // lintignore:AWSAT005
+			expected: `{"Statement":[{"Effect":"Deny","NotAction":"s3:GetObject","Resource":["arn:aws:s3:::b/*","arn:aws:s3:::a/*"]}]}`,                                                       // lintignore:AWSAT005
+		},
+		"different_not_actions": {
+			json:     `{"Statement":[{"Effect":"Deny","NotAction":"s3:GetObject","Resource":"arn:aws:s3:::a/*"},{"Effect":"Deny","NotAction":"s3:PutObject","Resource":"arn:aws:s3:::b/*"}]}`, // lintignore:AWSAT005
+			expected: `{"Statement":[{"Effect":"Deny","NotAction":"s3:GetObject","Resource":"arn:aws:s3:::a/*"},{"Effect":"Deny","NotAction":"s3:PutObject","Resource":"arn:aws:s3:::b/*"}]}`, // lintignore:AWSAT005
+		},
+		"not_action_and_action": {
+			json:     `{"Statement":[{"Effect":"Deny","NotAction":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"s3:PutObject","Resource":"*"}]}`,
+			expected: `{"Statement":[{"Effect":"Deny","NotAction":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"s3:PutObject","Resource":"*"}]}`,
+		},
+		"keeps_first_position": {
+			json:     `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"},{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*"}]}`,
+			expected: `{"Statement":[{"Effect":"Allow","Action":["s3:ListBucket","s3:GetObject"],"Resource":"*"},{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`,
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doc := &IAMPolicyDoc{}
+			if err := json.Unmarshal([]byte(testcase.json), doc); err != nil {
+				t.Fatalf("unexpected error unmarshalling policy: %s", err)
+			}
+
+			if err := doc.MergeStatements(); err != nil {
+				t.Fatalf("unexpected error merging statements: %s", err)
+			}
+
+			b, err := json.Marshal(doc)
+			if err != nil {
+				t.Fatalf("unexpected error marshalling policy: %s", err)
+			}
+
+			if got := string(b); got != testcase.expected {
+				t.Errorf("expected %s, got %s", testcase.expected, got)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyDocCollapseActions(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	doc := &IAMPolicyDoc{}
+	if err := json.Unmarshal([]byte(`{"Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:getObjectAcl","s3:PutObject"],"NotResource":"*"},{"Effect":"Deny","NotAction":"s3:GetObject"}]}`), doc); err != nil {
+		t.Fatalf("unexpected error unmarshalling policy: %s", err)
+	}
+
+	doc.CollapseActions([]string{"s3:Get*"})
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("unexpected error marshalling policy: %s", err)
+	}
+
+	expected := `{"Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:Get*"],"NotResource":"*"},{"Effect":"Deny","NotAction":"s3:GetObject"}]}`
+	if got := string(b); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestIAMPolicyDocChunks(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	statement := `{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}`
+	largeStatement := `{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject","s3:DeleteObject"],"Resource":"*"}`
+	doc := &IAMPolicyDoc{}
+	if err := json.Unmarshal([]byte(`{"Version":"2012-10-17","Statement":[`+statement+`,`+statement+`,`+statement+`]}`), doc); err != nil {
+		t.Fatalf("unexpected error unmarshalling policy: %s", err)
+	}
+	largeDoc := &IAMPolicyDoc{}
+	if err := json.Unmarshal([]byte(`{"Version":"2012-10-17","Statement":[`+statement+`,`+largeStatement+`,`+statement+`]}`), largeDoc); err != nil {
+		t.Fatalf("unexpected error unmarshalling policy: %s", err)
+	}
+
+	// Two statements fit in a chunk, but three do not.
+	maxSize := len(`{"Version":"2012-10-17","Statement":[` + statement + `,` + statement + `]}`)
+
+	testcases := map[string]struct {
+		doc       *IAMPolicyDoc
+		maxSize   int
+		expected  []int
+		oversized []int
+	}{
+		"one_chunk": {
+			doc:      doc,
+			maxSize:  6144,
+			expected: []int{3},
+		},
+		"two_chunks": {
+			doc:      doc,
+			maxSize:  maxSize,
+			expected: []int{2, 1},
+		},
+		"three_chunks": {
+			doc:      doc,
+			maxSize:  maxSize - 1,
+			expected: []int{1, 1, 1},
+		},
+		"statements_too_large": {
+			doc:       doc,
+			maxSize:   10,
+			expected:  []int{1, 1, 1},
+			oversized: []int{0, 1, 2},
+		},
+		"statement_too_large": {
+			doc:       largeDoc,
+			maxSize:   len(`{"Version":"2012-10-17","Statement":[` + statement + `]}`),
+			expected:  []int{1, 1, 1},
+			oversized: []int{1},
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			chunks, oversized, err := testcase.doc.Chunks(testcase.maxSize)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got []int
+			for _, chunk := range chunks {
+				if chunk.Version != testcase.doc.Version {
+					t.Errorf("expected Version %s, got %s", testcase.doc.Version, chunk.Version)
+				}
+				got = append(got, len(chunk.Statements))
+			}
+
+			if !reflect.DeepEqual(got, testcase.expected) {
+				t.Errorf("expected statements per chunk %v, got %v", testcase.expected, got)
+			}
+
+			if !reflect.DeepEqual(oversized, testcase.oversized) {
+				t.Errorf("expected oversized statements %v, got %v", testcase.oversized, oversized)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyDocChunksEmpty(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	chunks, _, err := (&IAMPolicyDoc{Version: "2012-10-17"}).Chunks(6144)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(chunks) != 1 {
+		t.Errorf("expected 1 chunk, got %d", len(chunks))
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_iam_service_last_accessed_details")
+func DataSourceServiceLastAccessedDetails() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceServiceLastAccessedDetailsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"granularity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      iam.AccessAdvisorUsageGranularityTypeActionLevel,
+				ValidateFunc: validation.StringInSlice(iam.AccessAdvisorUsageGranularityType_Values(), false),
+			},
+			"job_completion_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"services": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_authenticated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_authenticated_entity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_authenticated_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total_authenticated_entities": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tracked_actions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"last_accessed_entity": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"last_accessed_region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"last_accessed_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"unused": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"unused": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"unused_actions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"unused_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      90,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"unused_services": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceServiceLastAccessedDetailsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	arn := d.Get("arn").(string)
+	input := &iam.GenerateServiceLastAccessedDetailsInput{
+		Arn:         aws.String(arn),
+		Granularity: aws.String(d.Get("granularity").(string)),
+	}
+
+	output, err := conn.GenerateServiceLastAccessedDetailsWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "generating IAM Service Last Accessed Details (%s): %s", arn, err)
+	}
+
+	jobID := aws.StringValue(output.JobId)
+	details, err := waitServiceLastAccessedDetailsJobCompleted(ctx, conn, jobID, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Service Last Accessed Details (%s): waiting for job (%s) to complete: %s", arn, jobID, err)
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -d.Get("unused_days").(int))
+	services, unusedServices, unusedActions := flattenServicesLastAccessed(details.ServicesLastAccessed, cutoff)
+
+	d.SetId(arn)
+	d.Set("job_completion_date", aws.TimeValue(details.JobCompletionDate).Format(time.RFC3339))
+	d.Set("job_id", jobID)
+	if err := d.Set("services", services); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting services: %s", err)
+	}
+	d.Set("unused_actions", unusedActions)
+	d.Set("unused_services", unusedServices)
+
+	return diags
+}
+
+// flattenServicesLastAccessed returns the services, the namespaces of the services that have not been accessed since
+// cutoff and the names ("service:Action") of the tracked actions that have not been accessed since cutoff.
+func flattenServicesLastAccessed(apiObjects []*iam.ServiceLastAccessed, cutoff time.Time) ([]interface{}, []string, []string) {
+	var tfList []interface{}
+	var unusedServices, unusedActions []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		namespace := aws.StringValue(apiObject.ServiceNamespace)
+		unused := serviceLastAccessedUnused(apiObject.LastAuthenticated, cutoff)
+
+		tfMap := map[string]interface{}{
+			"last_authenticated":           serviceLastAccessedTime(apiObject.LastAuthenticated),
+			"last_authenticated_entity":    aws.StringValue(apiObject.LastAuthenticatedEntity),
+			"last_authenticated_region":    aws.StringValue(apiObject.LastAuthenticatedRegion),
+			"service_name":                 aws.StringValue(apiObject.ServiceName),
+			"service_namespace":            namespace,
+			"total_authenticated_entities": int(aws.Int64Value(apiObject.TotalAuthenticatedEntities)),
+			"unused":                       unused,
+		}
+
+		if unused {
+			unusedServices = append(unusedServices, namespace)
+		}
+
+		var tfActions []interface{}
+		for _, apiObject := range apiObject.TrackedActionsLastAccessed {
+			if apiObject == nil {
+				continue
+			}
+
+			unused := serviceLastAccessedUnused(apiObject.LastAccessedTime, cutoff)
+
+			tfActions = append(tfActions, map[string]interface{}{
+				"action_name":          aws.StringValue(apiObject.ActionName),
+				"last_accessed_entity": aws.StringValue(apiObject.LastAccessedEntity),
+				"last_accessed_region": aws.StringValue(apiObject.LastAccessedRegion),
+				"last_accessed_time":   serviceLastAccessedTime(apiObject.LastAccessedTime),
+				"unused":               unused,
+			})
+
+			if unused {
+				unusedActions = append(unusedActions, namespace+":"+aws.StringValue(apiObject.ActionName))
+			}
+		}
+		tfMap["tracked_actions"] = tfActions
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList, unusedServices, unusedActions
+}
+
+func serviceLastAccessedUnused(lastAccessed *time.Time, cutoff time.Time) bool {
+	return lastAccessed == nil || lastAccessed.Before(cutoff)
+}
+
+func serviceLastAccessedTime(lastAccessed *time.Time) string {
+	if lastAccessed == nil {
+		return ""
+	}
+
+	return aws.TimeValue(lastAccessed).Format(time.RFC3339)
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMServiceLastAccessedDetailsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iam_service_last_accessed_details.test"
+	resourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLastAccessedDetailsDataSourceConfig_basic(rName, "ACTION_LEVEL"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "granularity", "ACTION_LEVEL"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "job_completion_date"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "job_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "services.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "services.*", map[string]string{
+						"last_authenticated": "",
+						"service_namespace":  "s3",
+						"unused":             "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "services.*", map[string]string{
+						"last_authenticated": "",
+						"service_namespace":  "sqs",
+						"unused":             "true",
+					}),
+					resource.TestCheckResourceAttr(dataSourceName, "unused_days", "90"),
+					resource.TestCheckResourceAttr(dataSourceName, "unused_services.#", "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "unused_services.*", "s3"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "unused_services.*", "sqs"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMServiceLastAccessedDetailsDataSource_serviceLevel(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iam_service_last_accessed_details.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLastAccessedDetailsDataSourceConfig_basic(rName, "SERVICE_LEVEL"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "granularity", "SERVICE_LEVEL"),
+					resource.TestCheckResourceAttr(dataSourceName, "services.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "services.0.tracked_actions.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "services.1.tracked_actions.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "unused_actions.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "unused_services.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceLastAccessedDetailsDataSourceConfig_basic(rName, granularity string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["s3:GetObject", "sqs:SendMessage"]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+data "aws_iam_service_last_accessed_details" "test" {
+  arn         = aws_iam_role.test.arn
+  granularity = %[2]q
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, granularity)
+}
@@ -57,6 +57,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourcePolicyDocument,
 			TypeName: "aws_iam_policy_document",
 		},
+		{
+			Factory:  DataSourcePolicyEvaluation,
+			TypeName: "aws_iam_policy_evaluation",
+		},
 		{
 			Factory:  DataSourcePrincipalPolicySimulation,
 			TypeName: "aws_iam_principal_policy_simulation",
@@ -77,6 +81,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceServerCertificate,
 			TypeName: "aws_iam_server_certificate",
 		},
+		{
+			Factory:  DataSourceServiceLastAccessedDetails,
+			TypeName: "aws_iam_service_last_accessed_details",
+		},
 		{
 			Factory:  DataSourceSessionContext,
 			TypeName: "aws_iam_session_context",
@@ -0,0 +1,569 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotanalytics_datastore", name="Datastore")
+// @Tags(identifierAttribute="arn")
+func ResourceDatastore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDatastoreCreate,
+		ReadWithoutTimeout:   resourceDatastoreRead,
+		UpdateWithoutTimeout: resourceDatastoreUpdate,
+		DeleteWithoutTimeout: resourceDatastoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"customer_managed_s3": func() *schema.Schema {
+				v := customerManagedS3Schema()
+				v.ConflictsWith = []string{"iot_site_wise_multi_layer_storage"}
+				return v
+			}(),
+			"datastore_partitions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"partition": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 25,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute_partition": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"attribute_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+											},
+										},
+									},
+									"timestamp_partition": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"attribute_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"timestamp_format": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 50),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"file_format_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"parquet_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"schema_definition": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"column": {
+													Type:     schema.TypeList,
+													Required: true,
+													ForceNew: true,
+													MinItems: 1,
+													MaxItems: 100,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.StringLenBetween(1, 255),
+															},
+															"type": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.StringLenBetween(1, 131072),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"iot_site_wise_multi_layer_storage": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"customer_managed_s3"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"customer_managed_s3_storage": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(3, 255),
+									},
+									"key_prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"retention_period": retentionPeriodSchema(),
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDatastoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreateDatastoreInput{
+		DatastoreName:    aws.String(name),
+		DatastoreStorage: expandDatastoreStorage(d),
+		Tags:             getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("datastore_partitions"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DatastorePartitions = expandDatastorePartitions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("file_format_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.FileFormatConfiguration = expandFileFormatConfiguration(v.([]interface{})[0])
+	}
+
+	if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RetentionPeriod = expandRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.CreateDatastoreWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating IoT Analytics Datastore (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceDatastoreRead(ctx, d, meta)
+}
+
+func resourceDatastoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	datastore, err := FindDatastoreByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Datastore (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IoT Analytics Datastore (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", datastore.Arn)
+	if err := d.Set("customer_managed_s3", flattenCustomerManagedDatastoreS3Storage(datastore.Storage)); err != nil {
+		return diag.Errorf("setting customer_managed_s3: %s", err)
+	}
+	if err := d.Set("datastore_partitions", flattenDatastorePartitions(datastore.DatastorePartitions)); err != nil {
+		return diag.Errorf("setting datastore_partitions: %s", err)
+	}
+	if err := d.Set("file_format_configuration", flattenFileFormatConfiguration(datastore.FileFormatConfiguration)); err != nil {
+		return diag.Errorf("setting file_format_configuration: %s", err)
+	}
+	if err := d.Set("iot_site_wise_multi_layer_storage", flattenDatastoreIotSiteWiseMultiLayerStorage(datastore.Storage)); err != nil {
+		return diag.Errorf("setting iot_site_wise_multi_layer_storage: %s", err)
+	}
+	d.Set("name", datastore.Name)
+	if datastore.RetentionPeriod != nil {
+		if err := d.Set("retention_period", []interface{}{flattenRetentionPeriod(datastore.RetentionPeriod)}); err != nil {
+			return diag.Errorf("setting retention_period: %s", err)
+		}
+	} else {
+		d.Set("retention_period", nil)
+	}
+	d.Set("status", datastore.Status)
+
+	return nil
+}
+
+func resourceDatastoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotanalytics.UpdateDatastoreInput{
+			DatastoreName:    aws.String(d.Id()),
+			DatastoreStorage: expandDatastoreStorage(d),
+		}
+
+		if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.RetentionPeriod = expandRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err := conn.UpdateDatastoreWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating IoT Analytics Datastore (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceDatastoreRead(ctx, d, meta)
+}
+
+func resourceDatastoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	log.Printf("[INFO] Deleting IoT Analytics Datastore: %s", d.Id())
+	_, err := conn.DeleteDatastoreWithContext(ctx, &iotanalytics.DeleteDatastoreInput{
+		DatastoreName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting IoT Analytics Datastore (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindDatastoreByName(ctx context.Context, conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Datastore, error) {
+	input := &iotanalytics.DescribeDatastoreInput{
+		DatastoreName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDatastoreWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Datastore == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Datastore, nil
+}
+
+// expandDatastoreStorage falls back to service-managed storage when neither
+// customer-managed nor IoT SiteWise multi-layer storage is configured.
+func expandDatastoreStorage(d *schema.ResourceData) *iotanalytics.DatastoreStorage {
+	if v, ok := d.GetOk("customer_managed_s3"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		apiObject := &iotanalytics.CustomerManagedDatastoreS3Storage{
+			Bucket:  aws.String(tfMap["bucket"].(string)),
+			RoleArn: aws.String(tfMap["role_arn"].(string)),
+		}
+
+		if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+			apiObject.KeyPrefix = aws.String(v)
+		}
+
+		return &iotanalytics.DatastoreStorage{
+			CustomerManagedS3: apiObject,
+		}
+	}
+
+	if v, ok := d.GetOk("iot_site_wise_multi_layer_storage"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		apiObject := &iotanalytics.IotSiteWiseCustomerManagedDatastoreS3Storage{}
+
+		if v, ok := tfMap["customer_managed_s3_storage"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Bucket = aws.String(tfMap["bucket"].(string))
+
+			if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+				apiObject.KeyPrefix = aws.String(v)
+			}
+		}
+
+		return &iotanalytics.DatastoreStorage{
+			IotSiteWiseMultiLayerStorage: &iotanalytics.DatastoreIotSiteWiseMultiLayerStorage{
+				CustomerManagedS3Storage: apiObject,
+			},
+		}
+	}
+
+	return &iotanalytics.DatastoreStorage{
+		ServiceManagedS3: &iotanalytics.ServiceManagedDatastoreS3Storage{},
+	}
+}
+
+func flattenCustomerManagedDatastoreS3Storage(apiObject *iotanalytics.DatastoreStorage) []interface{} {
+	if apiObject == nil || apiObject.CustomerManagedS3 == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"bucket":     aws.StringValue(apiObject.CustomerManagedS3.Bucket),
+		"key_prefix": aws.StringValue(apiObject.CustomerManagedS3.KeyPrefix),
+		"role_arn":   aws.StringValue(apiObject.CustomerManagedS3.RoleArn),
+	}}
+}
+
+func flattenDatastoreIotSiteWiseMultiLayerStorage(apiObject *iotanalytics.DatastoreStorage) []interface{} {
+	if apiObject == nil || apiObject.IotSiteWiseMultiLayerStorage == nil || apiObject.IotSiteWiseMultiLayerStorage.CustomerManagedS3Storage == nil {
+		return nil
+	}
+
+	storage := apiObject.IotSiteWiseMultiLayerStorage.CustomerManagedS3Storage
+
+	return []interface{}{map[string]interface{}{
+		"customer_managed_s3_storage": []interface{}{map[string]interface{}{
+			"bucket":     aws.StringValue(storage.Bucket),
+			"key_prefix": aws.StringValue(storage.KeyPrefix),
+		}},
+	}}
+}
+
+// expandFileFormatConfiguration returns a Parquet configuration. An empty
+// parquet_configuration block (no schema definition) is still Parquet.
+func expandFileFormatConfiguration(tfMapRaw interface{}) *iotanalytics.FileFormatConfiguration {
+	apiObject := &iotanalytics.ParquetConfiguration{}
+
+	if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+		if v, ok := tfMap["parquet_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if v, ok := tfMap["schema_definition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				apiObject.SchemaDefinition = expandSchemaDefinition(v[0].(map[string]interface{}))
+			}
+		}
+	}
+
+	return &iotanalytics.FileFormatConfiguration{
+		ParquetConfiguration: apiObject,
+	}
+}
+
+func expandSchemaDefinition(tfMap map[string]interface{}) *iotanalytics.SchemaDefinition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.SchemaDefinition{}
+
+	for _, tfMapRaw := range tfMap["column"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.Columns = append(apiObject.Columns, &iotanalytics.Column{
+			Name: aws.String(tfMap["name"].(string)),
+			Type: aws.String(tfMap["type"].(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func flattenFileFormatConfiguration(apiObject *iotanalytics.FileFormatConfiguration) []interface{} {
+	if apiObject == nil || apiObject.ParquetConfiguration == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ParquetConfiguration.SchemaDefinition; v != nil {
+		var tfList []interface{}
+
+		for _, apiObject := range v.Columns {
+			if apiObject == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"name": aws.StringValue(apiObject.Name),
+				"type": aws.StringValue(apiObject.Type),
+			})
+		}
+
+		tfMap["schema_definition"] = []interface{}{map[string]interface{}{
+			"column": tfList,
+		}}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"parquet_configuration": []interface{}{tfMap},
+	}}
+}
+
+func expandDatastorePartitions(tfMap map[string]interface{}) *iotanalytics.DatastorePartitions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.DatastorePartitions{}
+
+	for _, tfMapRaw := range tfMap["partition"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		partition := &iotanalytics.DatastorePartition{}
+
+		if v, ok := tfMap["attribute_partition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			partition.AttributePartition = &iotanalytics.Partition{
+				AttributeName: aws.String(tfMap["attribute_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["timestamp_partition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			partition.TimestampPartition = &iotanalytics.TimestampPartition{
+				AttributeName: aws.String(tfMap["attribute_name"].(string)),
+			}
+
+			if v, ok := tfMap["timestamp_format"].(string); ok && v != "" {
+				partition.TimestampPartition.TimestampFormat = aws.String(v)
+			}
+		}
+
+		apiObject.Partitions = append(apiObject.Partitions, partition)
+	}
+
+	return apiObject
+}
+
+func flattenDatastorePartitions(apiObject *iotanalytics.DatastorePartitions) []interface{} {
+	if apiObject == nil || len(apiObject.Partitions) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObject.Partitions {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.AttributePartition; v != nil {
+			tfMap["attribute_partition"] = []interface{}{map[string]interface{}{
+				"attribute_name": aws.StringValue(v.AttributeName),
+			}}
+		}
+
+		if v := apiObject.TimestampPartition; v != nil {
+			tfMap["timestamp_partition"] = []interface{}{map[string]interface{}{
+				"attribute_name":   aws.StringValue(v.AttributeName),
+				"timestamp_format": aws.StringValue(v.TimestampFormat),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return []interface{}{map[string]interface{}{
+		"partition": tfList,
+	}}
+}
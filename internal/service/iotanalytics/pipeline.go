@@ -0,0 +1,568 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotanalytics_pipeline", name="Pipeline")
+// @Tags(identifierAttribute="arn")
+func ResourcePipeline() *schema.Resource {
+	activityNameSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		}
+	}
+	nextSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		}
+	}
+	enrichActivitySchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"attribute": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 256),
+					},
+					"name": activityNameSchema(),
+					"next": nextSchema(),
+					"role_arn": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+					"thing_name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 256),
+					},
+				},
+			},
+		}
+	}
+	attributeListActivitySchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"attributes": {
+						Type:     schema.TypeList,
+						Required: true,
+						MinItems: 1,
+						MaxItems: 50,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+					"name": activityNameSchema(),
+					"next": nextSchema(),
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePipelineCreate,
+		ReadWithoutTimeout:   resourcePipelineRead,
+		UpdateWithoutTimeout: resourcePipelineUpdate,
+		DeleteWithoutTimeout: resourcePipelineDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"activity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 25,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"add_attributes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attributes": {
+										Type:     schema.TypeMap,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"name": activityNameSchema(),
+									"next": nextSchema(),
+								},
+							},
+						},
+						"channel": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"channel_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validName,
+									},
+									"name": activityNameSchema(),
+									"next": nextSchema(),
+								},
+							},
+						},
+						"datastore": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"datastore_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validName,
+									},
+									"name": activityNameSchema(),
+								},
+							},
+						},
+						"device_registry_enrich": enrichActivitySchema(),
+						"device_shadow_enrich":   enrichActivitySchema(),
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"name": activityNameSchema(),
+									"next": nextSchema(),
+								},
+							},
+						},
+						"lambda": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"batch_size": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 1000),
+									},
+									"lambda_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+									"name": activityNameSchema(),
+									"next": nextSchema(),
+								},
+							},
+						},
+						"math": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"math": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"name": activityNameSchema(),
+									"next": nextSchema(),
+								},
+							},
+						},
+						"remove_attributes": attributeListActivitySchema(),
+						"select_attributes": attributeListActivitySchema(),
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePipelineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreatePipelineInput{
+		PipelineActivities: expandPipelineActivities(d.Get("activity").([]interface{})),
+		PipelineName:       aws.String(name),
+		Tags:               getTagsIn(ctx),
+	}
+
+	_, err := conn.CreatePipelineWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating IoT Analytics Pipeline (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourcePipelineRead(ctx, d, meta)
+}
+
+func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	pipeline, err := FindPipelineByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Pipeline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IoT Analytics Pipeline (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("activity", flattenPipelineActivities(pipeline.Activities)); err != nil {
+		return diag.Errorf("setting activity: %s", err)
+	}
+	d.Set("arn", pipeline.Arn)
+	d.Set("name", pipeline.Name)
+
+	return nil
+}
+
+func resourcePipelineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotanalytics.UpdatePipelineInput{
+			PipelineActivities: expandPipelineActivities(d.Get("activity").([]interface{})),
+			PipelineName:       aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdatePipelineWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating IoT Analytics Pipeline (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourcePipelineRead(ctx, d, meta)
+}
+
+func resourcePipelineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	log.Printf("[INFO] Deleting IoT Analytics Pipeline: %s", d.Id())
+	_, err := conn.DeletePipelineWithContext(ctx, &iotanalytics.DeletePipelineInput{
+		PipelineName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting IoT Analytics Pipeline (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindPipelineByName(ctx context.Context, conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Pipeline, error) {
+	input := &iotanalytics.DescribePipelineInput{
+		PipelineName: aws.String(name),
+	}
+
+	output, err := conn.DescribePipelineWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Pipeline == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Pipeline, nil
+}
+
+func expandPipelineActivities(tfList []interface{}) []*iotanalytics.PipelineActivity {
+	var apiObjects []*iotanalytics.PipelineActivity
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotanalytics.PipelineActivity{}
+
+		if tfMap := firstActivityBlock(tfMap, "add_attributes"); tfMap != nil {
+			apiObject.AddAttributes = &iotanalytics.AddAttributesActivity{
+				Attributes: flex.ExpandStringMap(tfMap["attributes"].(map[string]interface{})),
+				Name:       aws.String(tfMap["name"].(string)),
+				Next:       expandActivityNext(tfMap),
+			}
+		}
+
+		if tfMap := firstActivityBlock(tfMap, "channel"); tfMap != nil {
+			apiObject.Channel = &iotanalytics.ChannelActivity{
+				ChannelName: aws.String(tfMap["channel_name"].(string)),
+				Name:        aws.String(tfMap["name"].(string)),
+				Next:        expandActivityNext(tfMap),
+			}
+		}
+
+		if tfMap := firstActivityBlock(tfMap, "datastore"); tfMap != nil {
+			apiObject.Datastore = &iotanalytics.DatastoreActivity{
+				DatastoreName: aws.String(tfMap["datastore_name"].(string)),
+				Name:          aws.String(tfMap["name"].(string)),
+			}
+		}
+
+		if tfMap := firstActivityBlock(tfMap, "device_registry_enrich"); tfMap != nil {
+			apiObject.DeviceRegistryEnrich = &iotanalytics.DeviceRegistryEnrichActivity{
+				Attribute: aws.String(tfMap["attribute"].(string)),
+				Name:      aws.String(tfMap["name"].(string)),
+				Next:      expandActivityNext(tfMap),
+				RoleArn:   aws.String(tfMap["role_arn"].(string)),
+				ThingName: aws.String(tfMap["thing_name"].(string)),
+			}
+		}
+
+		if tfMap := firstActivityBlock(tfMap, "device_shadow_enrich"); tfMap != nil {
+			apiObject.DeviceShadowEnrich = &iotanalytics.DeviceShadowEnrichActivity{
+				Attribute: aws.String(tfMap["attribute"].(string)),
+				Name:      aws.String(tfMap["name"].(string)),
+				Next:      expandActivityNext(tfMap),
+				RoleArn:   aws.String(tfMap["role_arn"].(string)),
+				ThingName: aws.String(tfMap["thing_name"].(string)),
+			}
+		}
+
+		if tfMap := firstActivityBlock(tfMap, "filter"); tfMap != nil {
+			apiObject.Filter = &iotanalytics.FilterActivity{
+				Filter: aws.String(tfMap["filter"].(string)),
+				Name:   aws.String(tfMap["name"].(string)),
+				Next:   expandActivityNext(tfMap),
+			}
+		}
+
+		if tfMap := firstActivityBlock(tfMap, "lambda"); tfMap != nil {
+			apiObject.Lambda = &iotanalytics.LambdaActivity{
+				BatchSize:  aws.Int64(int64(tfMap["batch_size"].(int))),
+				LambdaName: aws.String(tfMap["lambda_name"].(string)),
+				Name:       aws.String(tfMap["name"].(string)),
+				Next:       expandActivityNext(tfMap),
+			}
+		}
+
+		if tfMap := firstActivityBlock(tfMap, "math"); tfMap != nil {
+			apiObject.Math = &iotanalytics.MathActivity{
+				Attribute: aws.String(tfMap["attribute"].(string)),
+				Math:      aws.String(tfMap["math"].(string)),
+				Name:      aws.String(tfMap["name"].(string)),
+				Next:      expandActivityNext(tfMap),
+			}
+		}
+
+		if tfMap := firstActivityBlock(tfMap, "remove_attributes"); tfMap != nil {
+			apiObject.RemoveAttributes = &iotanalytics.RemoveAttributesActivity{
+				Attributes: flex.ExpandStringList(tfMap["attributes"].([]interface{})),
+				Name:       aws.String(tfMap["name"].(string)),
+				Next:       expandActivityNext(tfMap),
+			}
+		}
+
+		if tfMap := firstActivityBlock(tfMap, "select_attributes"); tfMap != nil {
+			apiObject.SelectAttributes = &iotanalytics.SelectAttributesActivity{
+				Attributes: flex.ExpandStringList(tfMap["attributes"].([]interface{})),
+				Name:       aws.String(tfMap["name"].(string)),
+				Next:       expandActivityNext(tfMap),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func firstActivityBlock(tfMap map[string]interface{}, key string) map[string]interface{} {
+	if v, ok := tfMap[key].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return v[0].(map[string]interface{})
+	}
+
+	return nil
+}
+
+func expandActivityNext(tfMap map[string]interface{}) *string {
+	if v, ok := tfMap["next"].(string); ok && v != "" {
+		return aws.String(v)
+	}
+
+	return nil
+}
+
+func flattenPipelineActivities(apiObjects []*iotanalytics.PipelineActivity) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.AddAttributes; v != nil {
+			tfMap["add_attributes"] = []interface{}{map[string]interface{}{
+				"attributes": aws.StringValueMap(v.Attributes),
+				"name":       aws.StringValue(v.Name),
+				"next":       aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.Channel; v != nil {
+			tfMap["channel"] = []interface{}{map[string]interface{}{
+				"channel_name": aws.StringValue(v.ChannelName),
+				"name":         aws.StringValue(v.Name),
+				"next":         aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.Datastore; v != nil {
+			tfMap["datastore"] = []interface{}{map[string]interface{}{
+				"datastore_name": aws.StringValue(v.DatastoreName),
+				"name":           aws.StringValue(v.Name),
+			}}
+		}
+
+		if v := apiObject.DeviceRegistryEnrich; v != nil {
+			tfMap["device_registry_enrich"] = []interface{}{map[string]interface{}{
+				"attribute":  aws.StringValue(v.Attribute),
+				"name":       aws.StringValue(v.Name),
+				"next":       aws.StringValue(v.Next),
+				"role_arn":   aws.StringValue(v.RoleArn),
+				"thing_name": aws.StringValue(v.ThingName),
+			}}
+		}
+
+		if v := apiObject.DeviceShadowEnrich; v != nil {
+			tfMap["device_shadow_enrich"] = []interface{}{map[string]interface{}{
+				"attribute":  aws.StringValue(v.Attribute),
+				"name":       aws.StringValue(v.Name),
+				"next":       aws.StringValue(v.Next),
+				"role_arn":   aws.StringValue(v.RoleArn),
+				"thing_name": aws.StringValue(v.ThingName),
+			}}
+		}
+
+		if v := apiObject.Filter; v != nil {
+			tfMap["filter"] = []interface{}{map[string]interface{}{
+				"filter": aws.StringValue(v.Filter),
+				"name":   aws.StringValue(v.Name),
+				"next":   aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.Lambda; v != nil {
+			tfMap["lambda"] = []interface{}{map[string]interface{}{
+				"batch_size":  aws.Int64Value(v.BatchSize),
+				"lambda_name": aws.StringValue(v.LambdaName),
+				"name":        aws.StringValue(v.Name),
+				"next":        aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.Math; v != nil {
+			tfMap["math"] = []interface{}{map[string]interface{}{
+				"attribute": aws.StringValue(v.Attribute),
+				"math":      aws.StringValue(v.Math),
+				"name":      aws.StringValue(v.Name),
+				"next":      aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.RemoveAttributes; v != nil {
+			tfMap["remove_attributes"] = []interface{}{map[string]interface{}{
+				"attributes": aws.StringValueSlice(v.Attributes),
+				"name":       aws.StringValue(v.Name),
+				"next":       aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.SelectAttributes; v != nil {
+			tfMap["select_attributes"] = []interface{}{map[string]interface{}{
+				"attributes": aws.StringValueSlice(v.Attributes),
+				"name":       aws.StringValue(v.Name),
+				"next":       aws.StringValue(v.Next),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
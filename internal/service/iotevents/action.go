@@ -0,0 +1,969 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// Actions are shared between detector model events and alarm model event actions.
+// Detector model events additionally support timer and variable actions.
+
+func payloadSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"content_expression": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(iotevents.PayloadType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func alarmActionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"dynamodb": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"hash_key_field": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"hash_key_type": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(dynamoDBKeyType_Values(), false),
+					},
+					"hash_key_value": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"operation": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(dynamoDBOperation_Values(), false),
+					},
+					"payload": payloadSchema(),
+					"payload_field": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"range_key_field": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"range_key_type": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(dynamoDBKeyType_Values(), false),
+					},
+					"range_key_value": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"table_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"dynamodb_v2": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"payload": payloadSchema(),
+					"table_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"firehose": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"delivery_stream_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"payload": payloadSchema(),
+					"separator": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"\n", "\t", "\r\n", ","}, false),
+					},
+				},
+			},
+		},
+		"iot_events": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"input_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"payload": payloadSchema(),
+				},
+			},
+		},
+		"iot_site_wise": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"asset_id": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"entry_id": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"property_alias": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"property_id": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"property_value": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"quality": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"timestamp": {
+									Type:     schema.TypeList,
+									Optional: true,
+									MaxItems: 1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"offset_in_nanos": {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+											"time_in_seconds": {
+												Type:     schema.TypeString,
+												Required: true,
+											},
+										},
+									},
+								},
+								"value": {
+									Type:     schema.TypeList,
+									Optional: true,
+									MaxItems: 1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"boolean_value": {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+											"double_value": {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+											"integer_value": {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+											"string_value": {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"iot_topic_publish": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"mqtt_topic": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 128),
+					},
+					"payload": payloadSchema(),
+				},
+			},
+		},
+		"lambda": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"function_arn": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+					"payload": payloadSchema(),
+				},
+			},
+		},
+		"sns": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"payload": payloadSchema(),
+					"target_arn": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+				},
+			},
+		},
+		"sqs": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"payload": payloadSchema(),
+					"queue_url": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"use_base64": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func actionSchema() *schema.Schema {
+	s := alarmActionSchema()
+
+	s["clear_timer"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"timer_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+	s["reset_timer"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"timer_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+	s["set_timer"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration_expression": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				"seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 31622400),
+				},
+				"timer_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+	s["set_variable"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"value": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				"variable_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: s,
+		},
+	}
+}
+
+const (
+	dynamoDBKeyTypeNumber = "NUMBER"
+	dynamoDBKeyTypeString = "STRING"
+)
+
+func dynamoDBKeyType_Values() []string {
+	return []string{
+		dynamoDBKeyTypeNumber,
+		dynamoDBKeyTypeString,
+	}
+}
+
+const (
+	dynamoDBOperationDelete = "DELETE"
+	dynamoDBOperationInsert = "INSERT"
+	dynamoDBOperationUpdate = "UPDATE"
+)
+
+func dynamoDBOperation_Values() []string {
+	return []string{
+		dynamoDBOperationDelete,
+		dynamoDBOperationInsert,
+		dynamoDBOperationUpdate,
+	}
+}
+
+func expandActionDatas(tfList []interface{}) []*iotevents.ActionData {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotevents.ActionData
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.ActionData{}
+
+		if v, ok := tfMap["clear_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ClearTimer = &iotevents.ClearTimerAction{
+				TimerName: aws.String(v[0].(map[string]interface{})["timer_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["dynamodb"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.DynamoDB = expandDynamoDBAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["dynamodb_v2"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.DynamoDBv2 = expandDynamoDBv2Action(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["firehose"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Firehose = expandFirehoseAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["iot_events"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.IotEvents = expandIoTEventsAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["iot_site_wise"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.IotSiteWise = expandIoTSiteWiseAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["iot_topic_publish"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.IotTopicPublish = expandIoTTopicPublishAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["lambda"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Lambda = expandLambdaAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["reset_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ResetTimer = &iotevents.ResetTimerAction{
+				TimerName: aws.String(v[0].(map[string]interface{})["timer_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["set_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.SetTimer = expandSetTimerAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["set_variable"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.SetVariable = &iotevents.SetVariableAction{
+				Value:        aws.String(tfMap["value"].(string)),
+				VariableName: aws.String(tfMap["variable_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["sns"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Sns = expandSNSTopicPublishAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["sqs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Sqs = expandSQSAction(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAlarmActions(tfList []interface{}) []*iotevents.AlarmAction {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotevents.AlarmAction
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.AlarmAction{}
+
+		if v, ok := tfMap["dynamodb"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.DynamoDB = expandDynamoDBAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["dynamodb_v2"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.DynamoDBv2 = expandDynamoDBv2Action(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["firehose"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Firehose = expandFirehoseAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["iot_events"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.IotEvents = expandIoTEventsAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["iot_site_wise"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.IotSiteWise = expandIoTSiteWiseAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["iot_topic_publish"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.IotTopicPublish = expandIoTTopicPublishAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["lambda"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Lambda = expandLambdaAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["sns"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Sns = expandSNSTopicPublishAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["sqs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Sqs = expandSQSAction(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPayload(tfList []interface{}) *iotevents.Payload {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &iotevents.Payload{
+		ContentExpression: aws.String(tfMap["content_expression"].(string)),
+		Type:              aws.String(tfMap["type"].(string)),
+	}
+}
+
+func expandDynamoDBAction(tfMap map[string]interface{}) *iotevents.DynamoDBAction {
+	apiObject := &iotevents.DynamoDBAction{
+		HashKeyField: aws.String(tfMap["hash_key_field"].(string)),
+		HashKeyValue: aws.String(tfMap["hash_key_value"].(string)),
+		Payload:      expandPayload(tfMap["payload"].([]interface{})),
+		TableName:    aws.String(tfMap["table_name"].(string)),
+	}
+
+	if v, ok := tfMap["hash_key_type"].(string); ok && v != "" {
+		apiObject.HashKeyType = aws.String(v)
+	}
+
+	if v, ok := tfMap["operation"].(string); ok && v != "" {
+		apiObject.Operation = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload_field"].(string); ok && v != "" {
+		apiObject.PayloadField = aws.String(v)
+	}
+
+	if v, ok := tfMap["range_key_field"].(string); ok && v != "" {
+		apiObject.RangeKeyField = aws.String(v)
+	}
+
+	if v, ok := tfMap["range_key_type"].(string); ok && v != "" {
+		apiObject.RangeKeyType = aws.String(v)
+	}
+
+	if v, ok := tfMap["range_key_value"].(string); ok && v != "" {
+		apiObject.RangeKeyValue = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDynamoDBv2Action(tfMap map[string]interface{}) *iotevents.DynamoDBv2Action {
+	return &iotevents.DynamoDBv2Action{
+		Payload:   expandPayload(tfMap["payload"].([]interface{})),
+		TableName: aws.String(tfMap["table_name"].(string)),
+	}
+}
+
+func expandFirehoseAction(tfMap map[string]interface{}) *iotevents.FirehoseAction {
+	apiObject := &iotevents.FirehoseAction{
+		DeliveryStreamName: aws.String(tfMap["delivery_stream_name"].(string)),
+		Payload:            expandPayload(tfMap["payload"].([]interface{})),
+	}
+
+	if v, ok := tfMap["separator"].(string); ok && v != "" {
+		apiObject.Separator = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIoTEventsAction(tfMap map[string]interface{}) *iotevents.Action {
+	return &iotevents.Action{
+		InputName: aws.String(tfMap["input_name"].(string)),
+		Payload:   expandPayload(tfMap["payload"].([]interface{})),
+	}
+}
+
+func expandIoTSiteWiseAction(tfMap map[string]interface{}) *iotevents.IotSiteWiseAction {
+	apiObject := &iotevents.IotSiteWiseAction{}
+
+	if v, ok := tfMap["asset_id"].(string); ok && v != "" {
+		apiObject.AssetId = aws.String(v)
+	}
+
+	if v, ok := tfMap["entry_id"].(string); ok && v != "" {
+		apiObject.EntryId = aws.String(v)
+	}
+
+	if v, ok := tfMap["property_alias"].(string); ok && v != "" {
+		apiObject.PropertyAlias = aws.String(v)
+	}
+
+	if v, ok := tfMap["property_id"].(string); ok && v != "" {
+		apiObject.PropertyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["property_value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PropertyValue = expandAssetPropertyValue(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAssetPropertyValue(tfMap map[string]interface{}) *iotevents.AssetPropertyValue {
+	apiObject := &iotevents.AssetPropertyValue{}
+
+	if v, ok := tfMap["quality"].(string); ok && v != "" {
+		apiObject.Quality = aws.String(v)
+	}
+
+	if v, ok := tfMap["timestamp"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Timestamp = &iotevents.AssetPropertyTimestamp{
+			TimeInSeconds: aws.String(tfMap["time_in_seconds"].(string)),
+		}
+
+		if v, ok := tfMap["offset_in_nanos"].(string); ok && v != "" {
+			apiObject.Timestamp.OffsetInNanos = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Value = &iotevents.AssetPropertyVariant{}
+
+		if v, ok := tfMap["boolean_value"].(string); ok && v != "" {
+			apiObject.Value.BooleanValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["double_value"].(string); ok && v != "" {
+			apiObject.Value.DoubleValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["integer_value"].(string); ok && v != "" {
+			apiObject.Value.IntegerValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["string_value"].(string); ok && v != "" {
+			apiObject.Value.StringValue = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandIoTTopicPublishAction(tfMap map[string]interface{}) *iotevents.IotTopicPublishAction {
+	return &iotevents.IotTopicPublishAction{
+		MqttTopic: aws.String(tfMap["mqtt_topic"].(string)),
+		Payload:   expandPayload(tfMap["payload"].([]interface{})),
+	}
+}
+
+func expandLambdaAction(tfMap map[string]interface{}) *iotevents.LambdaAction {
+	return &iotevents.LambdaAction{
+		FunctionArn: aws.String(tfMap["function_arn"].(string)),
+		Payload:     expandPayload(tfMap["payload"].([]interface{})),
+	}
+}
+
+func expandSetTimerAction(tfMap map[string]interface{}) *iotevents.SetTimerAction {
+	apiObject := &iotevents.SetTimerAction{
+		TimerName: aws.String(tfMap["timer_name"].(string)),
+	}
+
+	if v, ok := tfMap["duration_expression"].(string); ok && v != "" {
+		apiObject.DurationExpression = aws.String(v)
+	}
+
+	if v, ok := tfMap["seconds"].(int); ok && v != 0 {
+		apiObject.Seconds = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandSNSTopicPublishAction(tfMap map[string]interface{}) *iotevents.SNSTopicPublishAction {
+	return &iotevents.SNSTopicPublishAction{
+		Payload:   expandPayload(tfMap["payload"].([]interface{})),
+		TargetArn: aws.String(tfMap["target_arn"].(string)),
+	}
+}
+
+func expandSQSAction(tfMap map[string]interface{}) *iotevents.SqsAction {
+	return &iotevents.SqsAction{
+		Payload:   expandPayload(tfMap["payload"].([]interface{})),
+		QueueUrl:  aws.String(tfMap["queue_url"].(string)),
+		UseBase64: aws.Bool(tfMap["use_base64"].(bool)),
+	}
+}
+
+func flattenActionDatas(apiObjects []*iotevents.ActionData) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.ClearTimer; v != nil {
+			tfMap["clear_timer"] = []interface{}{map[string]interface{}{
+				"timer_name": aws.StringValue(v.TimerName),
+			}}
+		}
+
+		if v := apiObject.DynamoDB; v != nil {
+			tfMap["dynamodb"] = []interface{}{flattenDynamoDBAction(v)}
+		}
+
+		if v := apiObject.DynamoDBv2; v != nil {
+			tfMap["dynamodb_v2"] = []interface{}{flattenDynamoDBv2Action(v)}
+		}
+
+		if v := apiObject.Firehose; v != nil {
+			tfMap["firehose"] = []interface{}{flattenFirehoseAction(v)}
+		}
+
+		if v := apiObject.IotEvents; v != nil {
+			tfMap["iot_events"] = []interface{}{flattenIoTEventsAction(v)}
+		}
+
+		if v := apiObject.IotSiteWise; v != nil {
+			tfMap["iot_site_wise"] = []interface{}{flattenIoTSiteWiseAction(v)}
+		}
+
+		if v := apiObject.IotTopicPublish; v != nil {
+			tfMap["iot_topic_publish"] = []interface{}{flattenIoTTopicPublishAction(v)}
+		}
+
+		if v := apiObject.Lambda; v != nil {
+			tfMap["lambda"] = []interface{}{flattenLambdaAction(v)}
+		}
+
+		if v := apiObject.ResetTimer; v != nil {
+			tfMap["reset_timer"] = []interface{}{map[string]interface{}{
+				"timer_name": aws.StringValue(v.TimerName),
+			}}
+		}
+
+		if v := apiObject.SetTimer; v != nil {
+			tfMap["set_timer"] = []interface{}{map[string]interface{}{
+				"duration_expression": aws.StringValue(v.DurationExpression),
+				"seconds":             aws.Int64Value(v.Seconds),
+				"timer_name":          aws.StringValue(v.TimerName),
+			}}
+		}
+
+		if v := apiObject.SetVariable; v != nil {
+			tfMap["set_variable"] = []interface{}{map[string]interface{}{
+				"value":         aws.StringValue(v.Value),
+				"variable_name": aws.StringValue(v.VariableName),
+			}}
+		}
+
+		if v := apiObject.Sns; v != nil {
+			tfMap["sns"] = []interface{}{flattenSNSTopicPublishAction(v)}
+		}
+
+		if v := apiObject.Sqs; v != nil {
+			tfMap["sqs"] = []interface{}{flattenSQSAction(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenAlarmActions(apiObjects []*iotevents.AlarmAction) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.DynamoDB; v != nil {
+			tfMap["dynamodb"] = []interface{}{flattenDynamoDBAction(v)}
+		}
+
+		if v := apiObject.DynamoDBv2; v != nil {
+			tfMap["dynamodb_v2"] = []interface{}{flattenDynamoDBv2Action(v)}
+		}
+
+		if v := apiObject.Firehose; v != nil {
+			tfMap["firehose"] = []interface{}{flattenFirehoseAction(v)}
+		}
+
+		if v := apiObject.IotEvents; v != nil {
+			tfMap["iot_events"] = []interface{}{flattenIoTEventsAction(v)}
+		}
+
+		if v := apiObject.IotSiteWise; v != nil {
+			tfMap["iot_site_wise"] = []interface{}{flattenIoTSiteWiseAction(v)}
+		}
+
+		if v := apiObject.IotTopicPublish; v != nil {
+			tfMap["iot_topic_publish"] = []interface{}{flattenIoTTopicPublishAction(v)}
+		}
+
+		if v := apiObject.Lambda; v != nil {
+			tfMap["lambda"] = []interface{}{flattenLambdaAction(v)}
+		}
+
+		if v := apiObject.Sns; v != nil {
+			tfMap["sns"] = []interface{}{flattenSNSTopicPublishAction(v)}
+		}
+
+		if v := apiObject.Sqs; v != nil {
+			tfMap["sqs"] = []interface{}{flattenSQSAction(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenPayload(apiObject *iotevents.Payload) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"content_expression": aws.StringValue(apiObject.ContentExpression),
+		"type":               aws.StringValue(apiObject.Type),
+	}}
+}
+
+func flattenDynamoDBAction(apiObject *iotevents.DynamoDBAction) map[string]interface{} {
+	return map[string]interface{}{
+		"hash_key_field":  aws.StringValue(apiObject.HashKeyField),
+		"hash_key_type":   aws.StringValue(apiObject.HashKeyType),
+		"hash_key_value":  aws.StringValue(apiObject.HashKeyValue),
+		"operation":       aws.StringValue(apiObject.Operation),
+		"payload":         flattenPayload(apiObject.Payload),
+		"payload_field":   aws.StringValue(apiObject.PayloadField),
+		"range_key_field": aws.StringValue(apiObject.RangeKeyField),
+		"range_key_type":  aws.StringValue(apiObject.RangeKeyType),
+		"range_key_value": aws.StringValue(apiObject.RangeKeyValue),
+		"table_name":      aws.StringValue(apiObject.TableName),
+	}
+}
+
+func flattenDynamoDBv2Action(apiObject *iotevents.DynamoDBv2Action) map[string]interface{} {
+	return map[string]interface{}{
+		"payload":    flattenPayload(apiObject.Payload),
+		"table_name": aws.StringValue(apiObject.TableName),
+	}
+}
+
+func flattenFirehoseAction(apiObject *iotevents.FirehoseAction) map[string]interface{} {
+	return map[string]interface{}{
+		"delivery_stream_name": aws.StringValue(apiObject.DeliveryStreamName),
+		"payload":              flattenPayload(apiObject.Payload),
+		"separator":            aws.StringValue(apiObject.Separator),
+	}
+}
+
+func flattenIoTEventsAction(apiObject *iotevents.Action) map[string]interface{} {
+	return map[string]interface{}{
+		"input_name": aws.StringValue(apiObject.InputName),
+		"payload":    flattenPayload(apiObject.Payload),
+	}
+}
+
+func flattenIoTSiteWiseAction(apiObject *iotevents.IotSiteWiseAction) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"asset_id":       aws.StringValue(apiObject.AssetId),
+		"entry_id":       aws.StringValue(apiObject.EntryId),
+		"property_alias": aws.StringValue(apiObject.PropertyAlias),
+		"property_id":    aws.StringValue(apiObject.PropertyId),
+	}
+
+	if v := apiObject.PropertyValue; v != nil {
+		tfMapValue := map[string]interface{}{
+			"quality": aws.StringValue(v.Quality),
+		}
+
+		if v := v.Timestamp; v != nil {
+			tfMapValue["timestamp"] = []interface{}{map[string]interface{}{
+				"offset_in_nanos": aws.StringValue(v.OffsetInNanos),
+				"time_in_seconds": aws.StringValue(v.TimeInSeconds),
+			}}
+		}
+
+		if v := v.Value; v != nil {
+			tfMapValue["value"] = []interface{}{map[string]interface{}{
+				"boolean_value": aws.StringValue(v.BooleanValue),
+				"double_value":  aws.StringValue(v.DoubleValue),
+				"integer_value": aws.StringValue(v.IntegerValue),
+				"string_value":  aws.StringValue(v.StringValue),
+			}}
+		}
+
+		tfMap["property_value"] = []interface{}{tfMapValue}
+	}
+
+	return tfMap
+}
+
+func flattenIoTTopicPublishAction(apiObject *iotevents.IotTopicPublishAction) map[string]interface{} {
+	return map[string]interface{}{
+		"mqtt_topic": aws.StringValue(apiObject.MqttTopic),
+		"payload":    flattenPayload(apiObject.Payload),
+	}
+}
+
+func flattenLambdaAction(apiObject *iotevents.LambdaAction) map[string]interface{} {
+	return map[string]interface{}{
+		"function_arn": aws.StringValue(apiObject.FunctionArn),
+		"payload":      flattenPayload(apiObject.Payload),
+	}
+}
+
+func flattenSNSTopicPublishAction(apiObject *iotevents.SNSTopicPublishAction) map[string]interface{} {
+	return map[string]interface{}{
+		"payload":    flattenPayload(apiObject.Payload),
+		"target_arn": aws.StringValue(apiObject.TargetArn),
+	}
+}
+
+func flattenSQSAction(apiObject *iotevents.SqsAction) map[string]interface{} {
+	return map[string]interface{}{
+		"payload":    flattenPayload(apiObject.Payload),
+		"queue_url":  aws.StringValue(apiObject.QueueUrl),
+		"use_base64": aws.BoolValue(apiObject.UseBase64),
+	}
+}
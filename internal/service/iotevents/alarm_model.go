@@ -0,0 +1,847 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotevents_alarm_model", name="Alarm Model")
+// @Tags(identifierAttribute="arn")
+func ResourceAlarmModel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAlarmModelCreate,
+		ReadWithoutTimeout:   resourceAlarmModelRead,
+		UpdateWithoutTimeout: resourceAlarmModelUpdate,
+		DeleteWithoutTimeout: resourceAlarmModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alarm_capabilities": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"acknowledge_flow": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+						"initialization_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"disabled_on_initialization": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"alarm_event_actions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: alarmActionSchema(),
+							},
+						},
+					},
+				},
+			},
+			"alarm_notification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"notification_action": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 10,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"lambda_action": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"function_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: verify.ValidARN,
+															},
+															"payload": payloadSchema(),
+														},
+													},
+												},
+											},
+										},
+									},
+									"email_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"content": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"additional_message": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+															"subject": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+												"from": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"recipients": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"to": recipientDetailSchema(),
+														},
+													},
+												},
+											},
+										},
+									},
+									"sms_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"additional_message": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"recipients": recipientDetailSchema(),
+												"sender_id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"alarm_rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"simple_rule": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"comparison_operator": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(iotevents.ComparisonOperator_Values(), false),
+									},
+									"input_property": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"threshold": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"severity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func recipientDetailSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"sso_identity": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"identity_store_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"user_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlarmModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &iotevents.CreateAlarmModelInput{
+		AlarmModelName: aws.String(name),
+		AlarmRule:      expandAlarmRule(d.Get("alarm_rule").([]interface{})),
+		RoleArn:        aws.String(d.Get("role_arn").(string)),
+		Tags:           getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("alarm_capabilities"); ok {
+		input.AlarmCapabilities = expandAlarmCapabilities(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("alarm_event_actions"); ok {
+		input.AlarmEventActions = expandAlarmEventActions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("alarm_notification"); ok {
+		input.AlarmNotification = expandAlarmNotification(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.AlarmModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("key"); ok {
+		input.Key = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("severity"); ok {
+		input.Severity = aws.Int64(int64(v.(int)))
+	}
+
+	_, err := conn.CreateAlarmModelWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating IoT Events Alarm Model (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitAlarmModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for IoT Events Alarm Model (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceAlarmModelRead(ctx, d, meta)
+}
+
+func resourceAlarmModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	output, err := FindAlarmModelByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Events Alarm Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IoT Events Alarm Model (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("alarm_capabilities", flattenAlarmCapabilities(output.AlarmCapabilities)); err != nil {
+		return diag.Errorf("setting alarm_capabilities: %s", err)
+	}
+	if err := d.Set("alarm_event_actions", flattenAlarmEventActions(output.AlarmEventActions)); err != nil {
+		return diag.Errorf("setting alarm_event_actions: %s", err)
+	}
+	if err := d.Set("alarm_notification", flattenAlarmNotification(output.AlarmNotification)); err != nil {
+		return diag.Errorf("setting alarm_notification: %s", err)
+	}
+	if err := d.Set("alarm_rule", flattenAlarmRule(output.AlarmRule)); err != nil {
+		return diag.Errorf("setting alarm_rule: %s", err)
+	}
+	d.Set("arn", output.AlarmModelArn)
+	d.Set("description", output.AlarmModelDescription)
+	d.Set("key", output.Key)
+	d.Set("name", output.AlarmModelName)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("severity", output.Severity)
+	d.Set("status", output.Status)
+	d.Set("version", output.AlarmModelVersion)
+
+	return nil
+}
+
+func resourceAlarmModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotevents.UpdateAlarmModelInput{
+			AlarmCapabilities:     expandAlarmCapabilities(d.Get("alarm_capabilities").([]interface{})),
+			AlarmEventActions:     expandAlarmEventActions(d.Get("alarm_event_actions").([]interface{})),
+			AlarmModelDescription: aws.String(d.Get("description").(string)),
+			AlarmModelName:        aws.String(d.Id()),
+			AlarmNotification:     expandAlarmNotification(d.Get("alarm_notification").([]interface{})),
+			AlarmRule:             expandAlarmRule(d.Get("alarm_rule").([]interface{})),
+			RoleArn:               aws.String(d.Get("role_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("severity"); ok {
+			input.Severity = aws.Int64(int64(v.(int)))
+		}
+
+		_, err := conn.UpdateAlarmModelWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating IoT Events Alarm Model (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAlarmModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for IoT Events Alarm Model (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAlarmModelRead(ctx, d, meta)
+}
+
+func resourceAlarmModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	log.Printf("[INFO] Deleting IoT Events Alarm Model: %s", d.Id())
+	_, err := conn.DeleteAlarmModelWithContext(ctx, &iotevents.DeleteAlarmModelInput{
+		AlarmModelName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting IoT Events Alarm Model (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAlarmModelDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for IoT Events Alarm Model (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindAlarmModelByName(ctx context.Context, conn *iotevents.IoTEvents, name string) (*iotevents.DescribeAlarmModelOutput, error) {
+	input := &iotevents.DescribeAlarmModelInput{
+		AlarmModelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeAlarmModelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAlarmModel(ctx context.Context, conn *iotevents.IoTEvents, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAlarmModelByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitAlarmModelActive(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.DescribeAlarmModelOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotevents.AlarmModelVersionStatusActivating},
+		Target:  []string{iotevents.AlarmModelVersionStatusActive},
+		Refresh: statusAlarmModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DescribeAlarmModelOutput); ok {
+		if status := aws.StringValue(output.Status); status == iotevents.AlarmModelVersionStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAlarmModelDeleted(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.DescribeAlarmModelOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: iotevents.AlarmModelVersionStatus_Values(),
+		Target:  []string{},
+		Refresh: statusAlarmModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DescribeAlarmModelOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandAlarmRule(tfList []interface{}) *iotevents.AlarmRule {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.AlarmRule{}
+
+	if v, ok := tfMap["simple_rule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.SimpleRule = &iotevents.SimpleRule{
+			ComparisonOperator: aws.String(tfMap["comparison_operator"].(string)),
+			InputProperty:      aws.String(tfMap["input_property"].(string)),
+			Threshold:          aws.String(tfMap["threshold"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandAlarmCapabilities(tfList []interface{}) *iotevents.AlarmCapabilities {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.AlarmCapabilities{}
+
+	if v, ok := tfMap["acknowledge_flow"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AcknowledgeFlow = &iotevents.AcknowledgeFlow{
+			Enabled: aws.Bool(v[0].(map[string]interface{})["enabled"].(bool)),
+		}
+	}
+
+	if v, ok := tfMap["initialization_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.InitializationConfiguration = &iotevents.InitializationConfiguration{
+			DisabledOnInitialization: aws.Bool(v[0].(map[string]interface{})["disabled_on_initialization"].(bool)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandAlarmEventActions(tfList []interface{}) *iotevents.AlarmEventActions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &iotevents.AlarmEventActions{
+		AlarmActions: expandAlarmActions(tfMap["alarm_action"].([]interface{})),
+	}
+}
+
+func expandAlarmNotification(tfList []interface{}) *iotevents.AlarmNotification {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.AlarmNotification{}
+
+	for _, tfMapRaw := range tfMap["notification_action"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		notificationAction := &iotevents.NotificationAction{
+			Action: &iotevents.NotificationTargetActions{},
+		}
+
+		if v, ok := tfMap["action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]interface{})["lambda_action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				notificationAction.Action.LambdaAction = expandLambdaAction(v[0].(map[string]interface{}))
+			}
+		}
+
+		for _, tfMapRaw := range tfMap["email_configuration"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			emailConfiguration := &iotevents.EmailConfiguration{
+				From: aws.String(tfMap["from"].(string)),
+			}
+
+			if v, ok := tfMap["content"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				emailConfiguration.Content = &iotevents.EmailContent{}
+
+				if v, ok := tfMap["additional_message"].(string); ok && v != "" {
+					emailConfiguration.Content.AdditionalMessage = aws.String(v)
+				}
+
+				if v, ok := tfMap["subject"].(string); ok && v != "" {
+					emailConfiguration.Content.Subject = aws.String(v)
+				}
+			}
+
+			if v, ok := tfMap["recipients"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				emailConfiguration.Recipients = &iotevents.EmailRecipients{
+					To: expandRecipientDetails(v[0].(map[string]interface{})["to"].([]interface{})),
+				}
+			}
+
+			notificationAction.EmailConfigurations = append(notificationAction.EmailConfigurations, emailConfiguration)
+		}
+
+		for _, tfMapRaw := range tfMap["sms_configuration"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			smsConfiguration := &iotevents.SMSConfiguration{
+				Recipients: expandRecipientDetails(tfMap["recipients"].([]interface{})),
+			}
+
+			if v, ok := tfMap["additional_message"].(string); ok && v != "" {
+				smsConfiguration.AdditionalMessage = aws.String(v)
+			}
+
+			if v, ok := tfMap["sender_id"].(string); ok && v != "" {
+				smsConfiguration.SenderId = aws.String(v)
+			}
+
+			notificationAction.SmsConfigurations = append(notificationAction.SmsConfigurations, smsConfiguration)
+		}
+
+		apiObject.NotificationActions = append(apiObject.NotificationActions, notificationAction)
+	}
+
+	return apiObject
+}
+
+func expandRecipientDetails(tfList []interface{}) []*iotevents.RecipientDetail {
+	var apiObjects []*iotevents.RecipientDetail
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.RecipientDetail{}
+
+		if v, ok := tfMap["sso_identity"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.SsoIdentity = &iotevents.SSOIdentity{
+				IdentityStoreId: aws.String(tfMap["identity_store_id"].(string)),
+			}
+
+			if v, ok := tfMap["user_id"].(string); ok && v != "" {
+				apiObject.SsoIdentity.UserId = aws.String(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAlarmRule(apiObject *iotevents.AlarmRule) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SimpleRule; v != nil {
+		tfMap["simple_rule"] = []interface{}{map[string]interface{}{
+			"comparison_operator": aws.StringValue(v.ComparisonOperator),
+			"input_property":      aws.StringValue(v.InputProperty),
+			"threshold":           aws.StringValue(v.Threshold),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAlarmCapabilities(apiObject *iotevents.AlarmCapabilities) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AcknowledgeFlow; v != nil {
+		tfMap["acknowledge_flow"] = []interface{}{map[string]interface{}{
+			"enabled": aws.BoolValue(v.Enabled),
+		}}
+	}
+
+	if v := apiObject.InitializationConfiguration; v != nil {
+		tfMap["initialization_configuration"] = []interface{}{map[string]interface{}{
+			"disabled_on_initialization": aws.BoolValue(v.DisabledOnInitialization),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAlarmEventActions(apiObject *iotevents.AlarmEventActions) []interface{} {
+	if apiObject == nil || len(apiObject.AlarmActions) == 0 {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"alarm_action": flattenAlarmActions(apiObject.AlarmActions),
+	}}
+}
+
+func flattenAlarmNotification(apiObject *iotevents.AlarmNotification) []interface{} {
+	if apiObject == nil || len(apiObject.NotificationActions) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObject.NotificationActions {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Action; v != nil && v.LambdaAction != nil {
+			tfMap["action"] = []interface{}{map[string]interface{}{
+				"lambda_action": []interface{}{flattenLambdaAction(v.LambdaAction)},
+			}}
+		}
+
+		var emailConfigurations []interface{}
+
+		for _, apiObject := range apiObject.EmailConfigurations {
+			if apiObject == nil {
+				continue
+			}
+
+			tfMap := map[string]interface{}{
+				"from": aws.StringValue(apiObject.From),
+			}
+
+			if v := apiObject.Content; v != nil {
+				tfMap["content"] = []interface{}{map[string]interface{}{
+					"additional_message": aws.StringValue(v.AdditionalMessage),
+					"subject":            aws.StringValue(v.Subject),
+				}}
+			}
+
+			if v := apiObject.Recipients; v != nil {
+				tfMap["recipients"] = []interface{}{map[string]interface{}{
+					"to": flattenRecipientDetails(v.To),
+				}}
+			}
+
+			emailConfigurations = append(emailConfigurations, tfMap)
+		}
+
+		tfMap["email_configuration"] = emailConfigurations
+
+		var smsConfigurations []interface{}
+
+		for _, apiObject := range apiObject.SmsConfigurations {
+			if apiObject == nil {
+				continue
+			}
+
+			smsConfigurations = append(smsConfigurations, map[string]interface{}{
+				"additional_message": aws.StringValue(apiObject.AdditionalMessage),
+				"recipients":         flattenRecipientDetails(apiObject.Recipients),
+				"sender_id":          aws.StringValue(apiObject.SenderId),
+			})
+		}
+
+		tfMap["sms_configuration"] = smsConfigurations
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return []interface{}{map[string]interface{}{
+		"notification_action": tfList,
+	}}
+}
+
+func flattenRecipientDetails(apiObjects []*iotevents.RecipientDetail) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.SsoIdentity; v != nil {
+			tfMap["sso_identity"] = []interface{}{map[string]interface{}{
+				"identity_store_id": aws.StringValue(v.IdentityStoreId),
+				"user_id":           aws.StringValue(v.UserId),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,734 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotevents_detector_model", name="Detector Model")
+// @Tags(identifierAttribute="arn")
+func ResourceDetectorModel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDetectorModelCreate,
+		ReadWithoutTimeout:   resourceDetectorModelRead,
+		UpdateWithoutTimeout: resourceDetectorModelUpdate,
+		DeleteWithoutTimeout: resourceDetectorModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"definition": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"definition", "definition_json"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"initial_state_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"state": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+									"on_enter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": eventSchema(),
+											},
+										},
+									},
+									"on_exit": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": eventSchema(),
+											},
+										},
+									},
+									"on_input": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": eventSchema(),
+												"transition_event": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"action": actionSchema(),
+															"condition": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 512),
+															},
+															"event_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 128),
+															},
+															"next_state": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 128),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"definition_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"definition", "definition_json"},
+				ValidateFunc:     validDetectorModelDefinitionJSON,
+				DiffSuppressFunc: suppressEquivalentDetectorModelDefinitionJSON,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"evaluation_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(iotevents.EvaluationMethod_Values(), false),
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffDetectorModelDefinition,
+		),
+	}
+}
+
+func eventSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action": actionSchema(),
+				"condition": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 512),
+				},
+				"event_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+}
+
+// customizeDiffDetectorModelDefinition keeps the two representations of the
+// detector model definition consistent in the plan.
+func customizeDiffDetectorModelDefinition(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	rawConfig := d.GetRawConfig()
+
+	if d.HasChange("definition") && rawConfig.GetAttr("definition_json").IsNull() {
+		return d.SetNewComputed("definition_json")
+	}
+
+	if d.HasChange("definition_json") && !rawConfig.GetAttr("definition_json").IsNull() {
+		return d.SetNewComputed("definition")
+	}
+
+	return nil
+}
+
+func resourceDetectorModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	definition, err := expandDetectorModelDefinitionFromConfig(d)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	input := &iotevents.CreateDetectorModelInput{
+		DetectorModelDefinition: definition,
+		DetectorModelName:       aws.String(name),
+		RoleArn:                 aws.String(d.Get("role_arn").(string)),
+		Tags:                    getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.DetectorModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("evaluation_method"); ok {
+		input.EvaluationMethod = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("key"); ok {
+		input.Key = aws.String(v.(string))
+	}
+
+	_, err = conn.CreateDetectorModelWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating IoT Events Detector Model (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitDetectorModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for IoT Events Detector Model (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceDetectorModelRead(ctx, d, meta)
+}
+
+func resourceDetectorModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	output, err := FindDetectorModelByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Events Detector Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IoT Events Detector Model (%s): %s", d.Id(), err)
+	}
+
+	configuration := output.DetectorModelConfiguration
+	d.Set("arn", configuration.DetectorModelArn)
+	if err := d.Set("definition", flattenDetectorModelDefinition(output.DetectorModelDefinition)); err != nil {
+		return diag.Errorf("setting definition: %s", err)
+	}
+	definitionJSON, err := flattenDetectorModelDefinitionJSON(output.DetectorModelDefinition)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("definition_json", definitionJSON)
+	d.Set("description", configuration.DetectorModelDescription)
+	d.Set("evaluation_method", configuration.EvaluationMethod)
+	d.Set("key", configuration.Key)
+	d.Set("name", configuration.DetectorModelName)
+	d.Set("role_arn", configuration.RoleArn)
+	d.Set("status", configuration.Status)
+	d.Set("version", configuration.DetectorModelVersion)
+
+	return nil
+}
+
+func resourceDetectorModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		definition, err := expandDetectorModelDefinitionFromConfig(d)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &iotevents.UpdateDetectorModelInput{
+			DetectorModelDefinition:  definition,
+			DetectorModelDescription: aws.String(d.Get("description").(string)),
+			DetectorModelName:        aws.String(d.Id()),
+			RoleArn:                  aws.String(d.Get("role_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("evaluation_method"); ok {
+			input.EvaluationMethod = aws.String(v.(string))
+		}
+
+		_, err = conn.UpdateDetectorModelWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating IoT Events Detector Model (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDetectorModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for IoT Events Detector Model (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDetectorModelRead(ctx, d, meta)
+}
+
+func resourceDetectorModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	log.Printf("[INFO] Deleting IoT Events Detector Model: %s", d.Id())
+	_, err := conn.DeleteDetectorModelWithContext(ctx, &iotevents.DeleteDetectorModelInput{
+		DetectorModelName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting IoT Events Detector Model (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDetectorModelDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for IoT Events Detector Model (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindDetectorModelByName(ctx context.Context, conn *iotevents.IoTEvents, name string) (*iotevents.DetectorModel, error) {
+	input := &iotevents.DescribeDetectorModelInput{
+		DetectorModelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDetectorModelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DetectorModel == nil || output.DetectorModel.DetectorModelConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DetectorModel, nil
+}
+
+func statusDetectorModel(ctx context.Context, conn *iotevents.IoTEvents, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDetectorModelByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DetectorModelConfiguration.Status), nil
+	}
+}
+
+func waitDetectorModelActive(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.DetectorModel, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotevents.DetectorModelVersionStatusActivating},
+		Target:  []string{iotevents.DetectorModelVersionStatusActive},
+		Refresh: statusDetectorModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DetectorModel); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDetectorModelDeleted(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.DetectorModel, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: iotevents.DetectorModelVersionStatus_Values(),
+		Target:  []string{},
+		Refresh: statusDetectorModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DetectorModel); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// expandDetectorModelDefinitionFromConfig returns the detector model definition from
+// whichever of `definition` or `definition_json` is present in configuration.
+func expandDetectorModelDefinitionFromConfig(d *schema.ResourceData) (*iotevents.DetectorModelDefinition, error) {
+	if !d.GetRawConfig().GetAttr("definition_json").IsNull() {
+		return expandDetectorModelDefinitionJSON(d.Get("definition_json").(string))
+	}
+
+	if v, ok := d.GetOk("definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		return expandDetectorModelDefinition(v.([]interface{})[0].(map[string]interface{})), nil
+	}
+
+	return nil, fmt.Errorf("one of `definition` or `definition_json` must be specified")
+}
+
+func expandDetectorModelDefinitionJSON(s string) (*iotevents.DetectorModelDefinition, error) {
+	apiObject := &iotevents.DetectorModelDefinition{}
+
+	if err := jsonutil.UnmarshalJSON(apiObject, bytes.NewReader([]byte(s))); err != nil {
+		return nil, fmt.Errorf("decoding detector model definition JSON: %w", err)
+	}
+
+	return apiObject, nil
+}
+
+func flattenDetectorModelDefinitionJSON(apiObject *iotevents.DetectorModelDefinition) (string, error) {
+	if apiObject == nil {
+		return "", nil
+	}
+
+	b, err := jsonutil.BuildJSON(apiObject)
+
+	if err != nil {
+		return "", fmt.Errorf("encoding detector model definition JSON: %w", err)
+	}
+
+	return string(b), nil
+}
+
+func validDetectorModelDefinitionJSON(v interface{}, k string) (ws []string, errors []error) {
+	apiObject, err := expandDetectorModelDefinitionJSON(v.(string))
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid detector model definition: %w", k, err))
+		return
+	}
+
+	if err := apiObject.Validate(); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid detector model definition: %w", k, err))
+	}
+
+	return
+}
+
+// suppressEquivalentDetectorModelDefinitionJSON compares detector model definitions
+// after round-tripping them through the API shape, which drops unknown keys and
+// normalizes ordering and whitespace.
+func suppressEquivalentDetectorModelDefinitionJSON(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	oldDefinition, err := expandDetectorModelDefinitionJSON(old)
+
+	if err != nil {
+		return false
+	}
+
+	newDefinition, err := expandDetectorModelDefinitionJSON(new)
+
+	if err != nil {
+		return false
+	}
+
+	oldJSON, err := flattenDetectorModelDefinitionJSON(oldDefinition)
+
+	if err != nil {
+		return false
+	}
+
+	newJSON, err := flattenDetectorModelDefinitionJSON(newDefinition)
+
+	if err != nil {
+		return false
+	}
+
+	return verify.JSONStringsEqual(oldJSON, newJSON)
+}
+
+func expandDetectorModelDefinition(tfMap map[string]interface{}) *iotevents.DetectorModelDefinition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.DetectorModelDefinition{
+		InitialStateName: aws.String(tfMap["initial_state_name"].(string)),
+	}
+
+	for _, tfMapRaw := range tfMap["state"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.States = append(apiObject.States, expandState(tfMap))
+	}
+
+	return apiObject
+}
+
+func expandState(tfMap map[string]interface{}) *iotevents.State {
+	apiObject := &iotevents.State{
+		StateName: aws.String(tfMap["name"].(string)),
+	}
+
+	if v, ok := tfMap["on_enter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OnEnter = &iotevents.OnEnterLifecycle{
+			Events: expandEvents(v[0].(map[string]interface{})["event"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["on_exit"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OnExit = &iotevents.OnExitLifecycle{
+			Events: expandEvents(v[0].(map[string]interface{})["event"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["on_input"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.OnInput = &iotevents.OnInputLifecycle{
+			Events:           expandEvents(tfMap["event"].([]interface{})),
+			TransitionEvents: expandTransitionEvents(tfMap["transition_event"].([]interface{})),
+		}
+	}
+
+	return apiObject
+}
+
+func expandEvents(tfList []interface{}) []*iotevents.Event {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotevents.Event
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.Event{
+			Actions:   expandActionDatas(tfMap["action"].([]interface{})),
+			EventName: aws.String(tfMap["event_name"].(string)),
+		}
+
+		if v, ok := tfMap["condition"].(string); ok && v != "" {
+			apiObject.Condition = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTransitionEvents(tfList []interface{}) []*iotevents.TransitionEvent {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotevents.TransitionEvent
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &iotevents.TransitionEvent{
+			Actions:   expandActionDatas(tfMap["action"].([]interface{})),
+			Condition: aws.String(tfMap["condition"].(string)),
+			EventName: aws.String(tfMap["event_name"].(string)),
+			NextState: aws.String(tfMap["next_state"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenDetectorModelDefinition(apiObject *iotevents.DetectorModelDefinition) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObject.States {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenState(apiObject))
+	}
+
+	return []interface{}{map[string]interface{}{
+		"initial_state_name": aws.StringValue(apiObject.InitialStateName),
+		"state":              tfList,
+	}}
+}
+
+func flattenState(apiObject *iotevents.State) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"name": aws.StringValue(apiObject.StateName),
+	}
+
+	if v := apiObject.OnEnter; v != nil {
+		tfMap["on_enter"] = []interface{}{map[string]interface{}{
+			"event": flattenEvents(v.Events),
+		}}
+	}
+
+	if v := apiObject.OnExit; v != nil {
+		tfMap["on_exit"] = []interface{}{map[string]interface{}{
+			"event": flattenEvents(v.Events),
+		}}
+	}
+
+	if v := apiObject.OnInput; v != nil {
+		tfMap["on_input"] = []interface{}{map[string]interface{}{
+			"event":            flattenEvents(v.Events),
+			"transition_event": flattenTransitionEvents(v.TransitionEvents),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenEvents(apiObjects []*iotevents.Event) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"action":     flattenActionDatas(apiObject.Actions),
+			"condition":  aws.StringValue(apiObject.Condition),
+			"event_name": aws.StringValue(apiObject.EventName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenTransitionEvents(apiObjects []*iotevents.TransitionEvent) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"action":     flattenActionDatas(apiObject.Actions),
+			"condition":  aws.StringValue(apiObject.Condition),
+			"event_name": aws.StringValue(apiObject.EventName),
+			"next_state": aws.StringValue(apiObject.NextState),
+		})
+	}
+
+	return tfList
+}
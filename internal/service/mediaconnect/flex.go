@@ -0,0 +1,508 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func expandEncryption(tfList []interface{}) *mediaconnect.Encryption {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &mediaconnect.Encryption{
+		RoleArn: aws.String(tfMap["role_arn"].(string)),
+	}
+
+	if v, ok := tfMap["algorithm"].(string); ok && v != "" {
+		apiObject.Algorithm = aws.String(v)
+	}
+
+	if v, ok := tfMap["constant_initialization_vector"].(string); ok && v != "" {
+		apiObject.ConstantInitializationVector = aws.String(v)
+	}
+
+	if v, ok := tfMap["device_id"].(string); ok && v != "" {
+		apiObject.DeviceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_type"].(string); ok && v != "" {
+		apiObject.KeyType = aws.String(v)
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		apiObject.Region = aws.String(v)
+	}
+
+	if v, ok := tfMap["resource_id"].(string); ok && v != "" {
+		apiObject.ResourceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["secret_arn"].(string); ok && v != "" {
+		apiObject.SecretArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["url"].(string); ok && v != "" {
+		apiObject.Url = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandUpdateEncryption(tfList []interface{}) *mediaconnect.UpdateEncryption {
+	apiObject := expandEncryption(tfList)
+
+	if apiObject == nil {
+		return nil
+	}
+
+	return &mediaconnect.UpdateEncryption{
+		Algorithm:                    apiObject.Algorithm,
+		ConstantInitializationVector: apiObject.ConstantInitializationVector,
+		DeviceId:                     apiObject.DeviceId,
+		KeyType:                      apiObject.KeyType,
+		Region:                       apiObject.Region,
+		ResourceId:                   apiObject.ResourceId,
+		RoleArn:                      apiObject.RoleArn,
+		SecretArn:                    apiObject.SecretArn,
+		Url:                          apiObject.Url,
+	}
+}
+
+func flattenEncryption(apiObject *mediaconnect.Encryption) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"algorithm":                      aws.StringValue(apiObject.Algorithm),
+		"constant_initialization_vector": aws.StringValue(apiObject.ConstantInitializationVector),
+		"device_id":                      aws.StringValue(apiObject.DeviceId),
+		"key_type":                       aws.StringValue(apiObject.KeyType),
+		"region":                         aws.StringValue(apiObject.Region),
+		"resource_id":                    aws.StringValue(apiObject.ResourceId),
+		"role_arn":                       aws.StringValue(apiObject.RoleArn),
+		"secret_arn":                     aws.StringValue(apiObject.SecretArn),
+		"url":                            aws.StringValue(apiObject.Url),
+	}}
+}
+
+func expandAddOutputRequest(tfMap map[string]interface{}) *mediaconnect.AddOutputRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediaconnect.AddOutputRequest{
+		Name:     aws.String(tfMap["name"].(string)),
+		Protocol: aws.String(tfMap["protocol"].(string)),
+	}
+
+	if v, ok := tfMap["cidr_allow_list"].([]interface{}); ok && len(v) > 0 {
+		apiObject.CidrAllowList = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["destination"].(string); ok && v != "" {
+		apiObject.Destination = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption"].([]interface{}); ok {
+		apiObject.Encryption = expandEncryption(v)
+	}
+
+	if v, ok := tfMap["max_latency"].(int); ok && v != 0 {
+		apiObject.MaxLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["min_latency"].(int); ok && v != 0 {
+		apiObject.MinLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["port"].(int); ok && v != 0 {
+		apiObject.Port = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["remote_id"].(string); ok && v != "" {
+		apiObject.RemoteId = aws.String(v)
+	}
+
+	if v, ok := tfMap["sender_control_port"].(int); ok && v != 0 {
+		apiObject.SenderControlPort = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["smoothing_latency"].(int); ok && v != 0 {
+		apiObject.SmoothingLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["stream_id"].(string); ok && v != "" {
+		apiObject.StreamId = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc_interface_attachment"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.VpcInterfaceAttachment = &mediaconnect.VpcInterfaceAttachment{
+			VpcInterfaceName: aws.String(v[0].(map[string]interface{})["vpc_interface_name"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+// expandUpdateFlowOutputInput returns an update request for the output described by tfMap.
+// The caller is responsible for setting FlowArn and OutputArn.
+func expandUpdateFlowOutputInput(tfMap map[string]interface{}) *mediaconnect.UpdateFlowOutputInput {
+	apiObject := expandAddOutputRequest(tfMap)
+
+	if apiObject == nil {
+		return nil
+	}
+
+	input := &mediaconnect.UpdateFlowOutputInput{
+		CidrAllowList:          apiObject.CidrAllowList,
+		Description:            apiObject.Description,
+		Destination:            apiObject.Destination,
+		MaxLatency:             apiObject.MaxLatency,
+		MinLatency:             apiObject.MinLatency,
+		Port:                   apiObject.Port,
+		Protocol:               apiObject.Protocol,
+		RemoteId:               apiObject.RemoteId,
+		SenderControlPort:      apiObject.SenderControlPort,
+		SmoothingLatency:       apiObject.SmoothingLatency,
+		StreamId:               apiObject.StreamId,
+		VpcInterfaceAttachment: apiObject.VpcInterfaceAttachment,
+	}
+
+	if v, ok := tfMap["encryption"].([]interface{}); ok {
+		input.Encryption = expandUpdateEncryption(v)
+	}
+
+	return input
+}
+
+func flattenOutput(apiObject *mediaconnect.Output) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"arn":                      aws.StringValue(apiObject.OutputArn),
+		"description":              aws.StringValue(apiObject.Description),
+		"destination":              aws.StringValue(apiObject.Destination),
+		"encryption":               flattenEncryption(apiObject.Encryption),
+		"name":                     aws.StringValue(apiObject.Name),
+		"port":                     aws.Int64Value(apiObject.Port),
+		"vpc_interface_attachment": nil,
+	}
+
+	if v := apiObject.Transport; v != nil {
+		tfMap["cidr_allow_list"] = aws.StringValueSlice(v.CidrAllowList)
+		tfMap["max_latency"] = aws.Int64Value(v.MaxLatency)
+		tfMap["min_latency"] = aws.Int64Value(v.MinLatency)
+		tfMap["protocol"] = aws.StringValue(v.Protocol)
+		tfMap["remote_id"] = aws.StringValue(v.RemoteId)
+		tfMap["sender_control_port"] = aws.Int64Value(v.SenderControlPort)
+		tfMap["smoothing_latency"] = aws.Int64Value(v.SmoothingLatency)
+		tfMap["stream_id"] = aws.StringValue(v.StreamId)
+	}
+
+	if v := apiObject.VpcInterfaceAttachment; v != nil {
+		tfMap["vpc_interface_attachment"] = []interface{}{map[string]interface{}{
+			"vpc_interface_name": aws.StringValue(v.VpcInterfaceName),
+		}}
+	}
+
+	return tfMap
+}
+
+func expandSetSourceRequest(tfMap map[string]interface{}) *mediaconnect.SetSourceRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediaconnect.SetSourceRequest{
+		Name: aws.String(tfMap["name"].(string)),
+	}
+
+	if v, ok := tfMap["decryption"].([]interface{}); ok {
+		apiObject.Decryption = expandEncryption(v)
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["entitlement_arn"].(string); ok && v != "" {
+		apiObject.EntitlementArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["ingest_port"].(int); ok && v != 0 {
+		apiObject.IngestPort = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_bitrate"].(int); ok && v != 0 {
+		apiObject.MaxBitrate = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_latency"].(int); ok && v != 0 {
+		apiObject.MaxLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_sync_buffer"].(int); ok && v != 0 {
+		apiObject.MaxSyncBuffer = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["min_latency"].(int); ok && v != 0 {
+		apiObject.MinLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = aws.String(v)
+	}
+
+	if v, ok := tfMap["sender_control_port"].(int); ok && v != 0 {
+		apiObject.SenderControlPort = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["sender_ip_address"].(string); ok && v != "" {
+		apiObject.SenderIpAddress = aws.String(v)
+	}
+
+	if v, ok := tfMap["source_listener_address"].(string); ok && v != "" {
+		apiObject.SourceListenerAddress = aws.String(v)
+	}
+
+	if v, ok := tfMap["source_listener_port"].(int); ok && v != 0 {
+		apiObject.SourceListenerPort = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["stream_id"].(string); ok && v != "" {
+		apiObject.StreamId = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc_interface_name"].(string); ok && v != "" {
+		apiObject.VpcInterfaceName = aws.String(v)
+	}
+
+	if v, ok := tfMap["whitelist_cidr"].(string); ok && v != "" {
+		apiObject.WhitelistCidr = aws.String(v)
+	}
+
+	return apiObject
+}
+
+// expandUpdateFlowSourceInput returns an update request for the source described by tfMap.
+// The caller is responsible for setting FlowArn and SourceArn.
+func expandUpdateFlowSourceInput(tfMap map[string]interface{}) *mediaconnect.UpdateFlowSourceInput {
+	apiObject := expandSetSourceRequest(tfMap)
+
+	if apiObject == nil {
+		return nil
+	}
+
+	input := &mediaconnect.UpdateFlowSourceInput{
+		Description:           apiObject.Description,
+		EntitlementArn:        apiObject.EntitlementArn,
+		IngestPort:            apiObject.IngestPort,
+		MaxBitrate:            apiObject.MaxBitrate,
+		MaxLatency:            apiObject.MaxLatency,
+		MaxSyncBuffer:         apiObject.MaxSyncBuffer,
+		MinLatency:            apiObject.MinLatency,
+		Protocol:              apiObject.Protocol,
+		SenderControlPort:     apiObject.SenderControlPort,
+		SenderIpAddress:       apiObject.SenderIpAddress,
+		SourceListenerAddress: apiObject.SourceListenerAddress,
+		SourceListenerPort:    apiObject.SourceListenerPort,
+		StreamId:              apiObject.StreamId,
+		VpcInterfaceName:      apiObject.VpcInterfaceName,
+		WhitelistCidr:         apiObject.WhitelistCidr,
+	}
+
+	if v, ok := tfMap["decryption"].([]interface{}); ok {
+		input.Decryption = expandUpdateEncryption(v)
+	}
+
+	return input
+}
+
+func flattenSource(apiObject *mediaconnect.Source) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"arn":                 aws.StringValue(apiObject.SourceArn),
+		"decryption":          flattenEncryption(apiObject.Decryption),
+		"description":         aws.StringValue(apiObject.Description),
+		"entitlement_arn":     aws.StringValue(apiObject.EntitlementArn),
+		"ingest_ip":           aws.StringValue(apiObject.IngestIp),
+		"ingest_port":         aws.Int64Value(apiObject.IngestPort),
+		"name":                aws.StringValue(apiObject.Name),
+		"sender_control_port": aws.Int64Value(apiObject.SenderControlPort),
+		"sender_ip_address":   aws.StringValue(apiObject.SenderIpAddress),
+		"vpc_interface_name":  aws.StringValue(apiObject.VpcInterfaceName),
+		"whitelist_cidr":      aws.StringValue(apiObject.WhitelistCidr),
+	}
+
+	if v := apiObject.Transport; v != nil {
+		tfMap["max_bitrate"] = aws.Int64Value(v.MaxBitrate)
+		tfMap["max_latency"] = aws.Int64Value(v.MaxLatency)
+		tfMap["max_sync_buffer"] = aws.Int64Value(v.MaxSyncBuffer)
+		tfMap["min_latency"] = aws.Int64Value(v.MinLatency)
+		tfMap["protocol"] = aws.StringValue(v.Protocol)
+		tfMap["source_listener_address"] = aws.StringValue(v.SourceListenerAddress)
+		tfMap["source_listener_port"] = aws.Int64Value(v.SourceListenerPort)
+		tfMap["stream_id"] = aws.StringValue(v.StreamId)
+	}
+
+	return tfMap
+}
+
+func expandGrantEntitlementRequest(tfMap map[string]interface{}) *mediaconnect.GrantEntitlementRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediaconnect.GrantEntitlementRequest{
+		Name:        aws.String(tfMap["name"].(string)),
+		Subscribers: flex.ExpandStringSet(tfMap["subscribers"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["data_transfer_subscriber_fee_percent"].(int); ok && v != 0 {
+		apiObject.DataTransferSubscriberFeePercent = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption"].([]interface{}); ok {
+		apiObject.Encryption = expandEncryption(v)
+	}
+
+	if v, ok := tfMap["entitlement_status"].(string); ok && v != "" {
+		apiObject.EntitlementStatus = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenEntitlement(apiObject *mediaconnect.Entitlement) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"arn":                                  aws.StringValue(apiObject.EntitlementArn),
+		"data_transfer_subscriber_fee_percent": aws.Int64Value(apiObject.DataTransferSubscriberFeePercent),
+		"description":                          aws.StringValue(apiObject.Description),
+		"encryption":                           flattenEncryption(apiObject.Encryption),
+		"entitlement_status":                   aws.StringValue(apiObject.EntitlementStatus),
+		"name":                                 aws.StringValue(apiObject.Name),
+		"subscribers":                          aws.StringValueSlice(apiObject.Subscribers),
+	}
+}
+
+func expandVPCInterfaceRequest(tfMap map[string]interface{}) *mediaconnect.VpcInterfaceRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediaconnect.VpcInterfaceRequest{
+		Name:             aws.String(tfMap["name"].(string)),
+		RoleArn:          aws.String(tfMap["role_arn"].(string)),
+		SecurityGroupIds: flex.ExpandStringSet(tfMap["security_group_ids"].(*schema.Set)),
+		SubnetId:         aws.String(tfMap["subnet_id"].(string)),
+	}
+
+	if v, ok := tfMap["network_interface_type"].(string); ok && v != "" {
+		apiObject.NetworkInterfaceType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenVPCInterface(apiObject *mediaconnect.VpcInterface) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"name":                   aws.StringValue(apiObject.Name),
+		"network_interface_ids":  aws.StringValueSlice(apiObject.NetworkInterfaceIds),
+		"network_interface_type": aws.StringValue(apiObject.NetworkInterfaceType),
+		"role_arn":               aws.StringValue(apiObject.RoleArn),
+		"security_group_ids":     aws.StringValueSlice(apiObject.SecurityGroupIds),
+		"subnet_id":              aws.StringValue(apiObject.SubnetId),
+	}
+}
+
+func expandFailoverConfig(tfMap map[string]interface{}) *mediaconnect.FailoverConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediaconnect.FailoverConfig{}
+
+	if v, ok := tfMap["failover_mode"].(string); ok && v != "" {
+		apiObject.FailoverMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["recovery_window"].(int); ok && v != 0 {
+		apiObject.RecoveryWindow = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["source_priority"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SourcePriority = &mediaconnect.SourcePriority{
+			PrimarySource: aws.String(v[0].(map[string]interface{})["primary_source"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["state"].(string); ok && v != "" {
+		apiObject.State = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandUpdateFailoverConfig(tfMap map[string]interface{}) *mediaconnect.UpdateFailoverConfig {
+	apiObject := expandFailoverConfig(tfMap)
+
+	if apiObject == nil {
+		return nil
+	}
+
+	return &mediaconnect.UpdateFailoverConfig{
+		FailoverMode:   apiObject.FailoverMode,
+		RecoveryWindow: apiObject.RecoveryWindow,
+		SourcePriority: apiObject.SourcePriority,
+		State:          apiObject.State,
+	}
+}
+
+func flattenFailoverConfig(apiObject *mediaconnect.FailoverConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"failover_mode":   aws.StringValue(apiObject.FailoverMode),
+		"recovery_window": aws.Int64Value(apiObject.RecoveryWindow),
+		"state":           aws.StringValue(apiObject.State),
+	}
+
+	if v := apiObject.SourcePriority; v != nil && v.PrimarySource != nil {
+		tfMap["source_priority"] = []interface{}{map[string]interface{}{
+			"primary_source": aws.StringValue(v.PrimarySource),
+		}}
+	}
+
+	return tfMap
+}
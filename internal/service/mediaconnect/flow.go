@@ -0,0 +1,876 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_mediaconnect_flow", name="Flow")
+// @Tags(identifierAttribute="arn")
+func ResourceFlow() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFlowCreate,
+		ReadWithoutTimeout:   resourceFlowRead,
+		UpdateWithoutTimeout: resourceFlowUpdate,
+		DeleteWithoutTimeout: resourceFlowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"egress_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"entitlement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"data_transfer_subscriber_fee_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"encryption": encryptionSchema(),
+						"entitlement_status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.EntitlementStatus_Values(), false),
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"subscribers": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidAccountID,
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"output": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: outputSchema(),
+				},
+			},
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: sourceSchema(),
+				},
+			},
+			"source_failover_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failover_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.FailoverMode_Values(), false),
+						},
+						"recovery_window": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"source_priority": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"primary_source": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"state": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.State_Values(), false),
+						},
+					},
+				},
+			},
+			"start_flow": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"vpc_interface": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"network_interface_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"network_interface_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.NetworkInterfaceType_Values(), false),
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFlowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &mediaconnect.CreateFlowInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		input.AvailabilityZone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("entitlement"); ok && len(v.([]interface{})) > 0 {
+		for _, tfMapRaw := range v.([]interface{}) {
+			input.Entitlements = append(input.Entitlements, expandGrantEntitlementRequest(tfMapRaw.(map[string]interface{})))
+		}
+	}
+
+	if v, ok := d.GetOk("output"); ok && len(v.([]interface{})) > 0 {
+		for _, tfMapRaw := range v.([]interface{}) {
+			input.Outputs = append(input.Outputs, expandAddOutputRequest(tfMapRaw.(map[string]interface{})))
+		}
+	}
+
+	if v := d.Get("source").([]interface{}); len(v) == 1 {
+		input.Source = expandSetSourceRequest(v[0].(map[string]interface{}))
+	} else {
+		for _, tfMapRaw := range v {
+			input.Sources = append(input.Sources, expandSetSourceRequest(tfMapRaw.(map[string]interface{})))
+		}
+	}
+
+	if v, ok := d.GetOk("source_failover_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SourceFailoverConfig = expandFailoverConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("vpc_interface"); ok && len(v.([]interface{})) > 0 {
+		for _, tfMapRaw := range v.([]interface{}) {
+			input.VpcInterfaces = append(input.VpcInterfaces, expandVPCInterfaceRequest(tfMapRaw.(map[string]interface{})))
+		}
+	}
+
+	output, err := conn.CreateFlowWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating MediaConnect Flow (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Flow.FlowArn))
+
+	if _, err := waitFlowCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for MediaConnect Flow (%s) create: %s", d.Id(), err)
+	}
+
+	if err := createTags(ctx, conn, d.Id(), getTagsIn(ctx)); err != nil {
+		return diag.Errorf("setting MediaConnect Flow (%s) tags: %s", d.Id(), err)
+	}
+
+	if d.Get("start_flow").(bool) {
+		if err := startFlow(ctx, conn, d.Timeout(schema.TimeoutCreate), d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceFlowRead(ctx, d, meta)
+}
+
+func resourceFlowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	flow, err := FindFlowByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaConnect Flow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	// Sources and outputs may also be managed by the aws_mediaconnect_flow_source and aws_mediaconnect_flow_output resources.
+	// Only those already known to this resource are kept, unless the flow is being imported.
+	importing := d.Get("name").(string) == ""
+	sourceNames := flowBlockNames(d, "source")
+	outputNames := flowBlockNames(d, "output")
+
+	d.Set("arn", flow.FlowArn)
+	d.Set("availability_zone", flow.AvailabilityZone)
+	d.Set("egress_ip", flow.EgressIp)
+	if err := d.Set("entitlement", flattenEntitlements(flow.Entitlements)); err != nil {
+		return diag.Errorf("setting entitlement: %s", err)
+	}
+	d.Set("name", flow.Name)
+	if err := d.Set("output", flattenOutputs(flow.Outputs, outputNames, importing)); err != nil {
+		return diag.Errorf("setting output: %s", err)
+	}
+	sources := flow.Sources
+	if len(sources) == 0 && flow.Source != nil {
+		sources = []*mediaconnect.Source{flow.Source}
+	}
+	if err := d.Set("source", flattenSources(sources, sourceNames, importing)); err != nil {
+		return diag.Errorf("setting source: %s", err)
+	}
+	if flow.SourceFailoverConfig != nil {
+		if err := d.Set("source_failover_config", []interface{}{flattenFailoverConfig(flow.SourceFailoverConfig)}); err != nil {
+			return diag.Errorf("setting source_failover_config: %s", err)
+		}
+	} else {
+		d.Set("source_failover_config", nil)
+	}
+	d.Set("start_flow", aws.StringValue(flow.Status) == mediaconnect.StatusActive)
+	d.Set("status", flow.Status)
+	if err := d.Set("vpc_interface", flattenVPCInterfaces(flow.VpcInterfaces)); err != nil {
+		return diag.Errorf("setting vpc_interface: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFlowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	if d.HasChange("source") {
+		o, n := d.GetChange("source")
+		add, update, del := diffFlowBlocks(o.([]interface{}), n.([]interface{}), "arn", "ingest_ip")
+
+		for _, tfMap := range del {
+			arn := tfMap["arn"].(string)
+			_, err := conn.RemoveFlowSourceWithContext(ctx, &mediaconnect.RemoveFlowSourceInput{
+				FlowArn:   aws.String(d.Id()),
+				SourceArn: aws.String(arn),
+			})
+
+			if err != nil {
+				return diag.Errorf("removing MediaConnect Flow (%s) source (%s): %s", d.Id(), arn, err)
+			}
+
+			if _, err := waitFlowUpdated(ctx, conn, d.Id(), timeout); err != nil {
+				return diag.Errorf("waiting for MediaConnect Flow (%s) update: %s", d.Id(), err)
+			}
+		}
+
+		for _, tfMap := range update {
+			input := expandUpdateFlowSourceInput(tfMap)
+			input.FlowArn = aws.String(d.Id())
+			input.SourceArn = aws.String(tfMap["arn"].(string))
+
+			_, err := conn.UpdateFlowSourceWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("updating MediaConnect Flow (%s) source (%s): %s", d.Id(), tfMap["name"].(string), err)
+			}
+
+			if _, err := waitFlowUpdated(ctx, conn, d.Id(), timeout); err != nil {
+				return diag.Errorf("waiting for MediaConnect Flow (%s) update: %s", d.Id(), err)
+			}
+		}
+
+		if len(add) > 0 {
+			input := &mediaconnect.AddFlowSourcesInput{
+				FlowArn: aws.String(d.Id()),
+			}
+
+			for _, tfMap := range add {
+				input.Sources = append(input.Sources, expandSetSourceRequest(tfMap))
+			}
+
+			_, err := conn.AddFlowSourcesWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("adding MediaConnect Flow (%s) sources: %s", d.Id(), err)
+			}
+
+			if _, err := waitFlowUpdated(ctx, conn, d.Id(), timeout); err != nil {
+				return diag.Errorf("waiting for MediaConnect Flow (%s) update: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("source_failover_config") {
+		if v, ok := d.GetOk("source_failover_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input := &mediaconnect.UpdateFlowInput{
+				FlowArn:              aws.String(d.Id()),
+				SourceFailoverConfig: expandUpdateFailoverConfig(v.([]interface{})[0].(map[string]interface{})),
+			}
+
+			_, err := conn.UpdateFlowWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("updating MediaConnect Flow (%s): %s", d.Id(), err)
+			}
+
+			if _, err := waitFlowUpdated(ctx, conn, d.Id(), timeout); err != nil {
+				return diag.Errorf("waiting for MediaConnect Flow (%s) update: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("output") {
+		o, n := d.GetChange("output")
+		add, update, del := diffFlowBlocks(o.([]interface{}), n.([]interface{}), "arn")
+
+		for _, tfMap := range del {
+			arn := tfMap["arn"].(string)
+			_, err := conn.RemoveFlowOutputWithContext(ctx, &mediaconnect.RemoveFlowOutputInput{
+				FlowArn:   aws.String(d.Id()),
+				OutputArn: aws.String(arn),
+			})
+
+			if err != nil {
+				return diag.Errorf("removing MediaConnect Flow (%s) output (%s): %s", d.Id(), arn, err)
+			}
+
+			if _, err := waitFlowUpdated(ctx, conn, d.Id(), timeout); err != nil {
+				return diag.Errorf("waiting for MediaConnect Flow (%s) update: %s", d.Id(), err)
+			}
+		}
+
+		for _, tfMap := range update {
+			input := expandUpdateFlowOutputInput(tfMap)
+			input.FlowArn = aws.String(d.Id())
+			input.OutputArn = aws.String(tfMap["arn"].(string))
+
+			_, err := conn.UpdateFlowOutputWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("updating MediaConnect Flow (%s) output (%s): %s", d.Id(), tfMap["name"].(string), err)
+			}
+
+			if _, err := waitFlowUpdated(ctx, conn, d.Id(), timeout); err != nil {
+				return diag.Errorf("waiting for MediaConnect Flow (%s) update: %s", d.Id(), err)
+			}
+		}
+
+		if len(add) > 0 {
+			input := &mediaconnect.AddFlowOutputsInput{
+				FlowArn: aws.String(d.Id()),
+			}
+
+			for _, tfMap := range add {
+				input.Outputs = append(input.Outputs, expandAddOutputRequest(tfMap))
+			}
+
+			_, err := conn.AddFlowOutputsWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("adding MediaConnect Flow (%s) outputs: %s", d.Id(), err)
+			}
+
+			if _, err := waitFlowUpdated(ctx, conn, d.Id(), timeout); err != nil {
+				return diag.Errorf("waiting for MediaConnect Flow (%s) update: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("entitlement") {
+		o, n := d.GetChange("entitlement")
+		add, update, del := diffFlowBlocks(o.([]interface{}), n.([]interface{}), "arn")
+
+		for _, tfMap := range del {
+			arn := tfMap["arn"].(string)
+			_, err := conn.RevokeFlowEntitlementWithContext(ctx, &mediaconnect.RevokeFlowEntitlementInput{
+				EntitlementArn: aws.String(arn),
+				FlowArn:        aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return diag.Errorf("revoking MediaConnect Flow (%s) entitlement (%s): %s", d.Id(), arn, err)
+			}
+		}
+
+		for _, tfMap := range update {
+			apiObject := expandGrantEntitlementRequest(tfMap)
+			input := &mediaconnect.UpdateFlowEntitlementInput{
+				Description:       apiObject.Description,
+				EntitlementArn:    aws.String(tfMap["arn"].(string)),
+				EntitlementStatus: apiObject.EntitlementStatus,
+				FlowArn:           aws.String(d.Id()),
+				Subscribers:       apiObject.Subscribers,
+			}
+
+			if v, ok := tfMap["encryption"].([]interface{}); ok {
+				input.Encryption = expandUpdateEncryption(v)
+			}
+
+			_, err := conn.UpdateFlowEntitlementWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("updating MediaConnect Flow (%s) entitlement (%s): %s", d.Id(), tfMap["name"].(string), err)
+			}
+		}
+
+		if len(add) > 0 {
+			input := &mediaconnect.GrantFlowEntitlementsInput{
+				FlowArn: aws.String(d.Id()),
+			}
+
+			for _, tfMap := range add {
+				input.Entitlements = append(input.Entitlements, expandGrantEntitlementRequest(tfMap))
+			}
+
+			_, err := conn.GrantFlowEntitlementsWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("granting MediaConnect Flow (%s) entitlements: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("start_flow") {
+		flow, err := FindFlowByARN(ctx, conn, d.Id())
+
+		if err != nil {
+			return diag.Errorf("reading MediaConnect Flow (%s): %s", d.Id(), err)
+		}
+
+		switch d.Get("start_flow").(bool) {
+		case true:
+			if aws.StringValue(flow.Status) == mediaconnect.StatusStandby {
+				if err := startFlow(ctx, conn, timeout, d.Id()); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		default:
+			if aws.StringValue(flow.Status) == mediaconnect.StatusActive {
+				if err := stopFlow(ctx, conn, timeout, d.Id()); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+	}
+
+	return resourceFlowRead(ctx, d, meta)
+}
+
+func resourceFlowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	flow, err := FindFlowByARN(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	if aws.StringValue(flow.Status) == mediaconnect.StatusActive {
+		if err := stopFlow(ctx, conn, d.Timeout(schema.TimeoutDelete), d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[INFO] Deleting MediaConnect Flow: %s", d.Id())
+	_, err = conn.DeleteFlowWithContext(ctx, &mediaconnect.DeleteFlowInput{
+		FlowArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFlowDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for MediaConnect Flow (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// flowBlockNames returns the names of the configured (or previously read) blocks for the specified attribute.
+func flowBlockNames(d *schema.ResourceData, key string) map[string]bool {
+	blockNames := make(map[string]bool)
+
+	for _, tfMapRaw := range d.Get(key).([]interface{}) {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			blockNames[tfMap["name"].(string)] = true
+		}
+	}
+
+	return blockNames
+}
+
+// diffFlowBlocks compares old and new lists of named blocks, returning those to be added, updated and removed.
+// The computed attributes are ignored when checking a block for changes. Updated blocks carry the old block's ARN.
+func diffFlowBlocks(o, n []interface{}, computed ...string) ([]map[string]interface{}, []map[string]interface{}, []map[string]interface{}) {
+	var add, update, del []map[string]interface{}
+
+	oldBlocks := make(map[string]map[string]interface{})
+	for _, tfMapRaw := range o {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			oldBlocks[tfMap["name"].(string)] = tfMap
+		}
+	}
+
+	newNames := make(map[string]bool)
+	for _, tfMapRaw := range n {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+		newNames[name] = true
+
+		old, ok := oldBlocks[name]
+		if !ok {
+			add = append(add, tfMap)
+			continue
+		}
+
+		if !reflect.DeepEqual(withoutKeys(old, computed...), withoutKeys(tfMap, computed...)) {
+			tfMap["arn"] = old["arn"]
+			update = append(update, tfMap)
+		}
+	}
+
+	for name, tfMap := range oldBlocks {
+		if !newNames[name] {
+			del = append(del, tfMap)
+		}
+	}
+
+	return add, update, del
+}
+
+func withoutKeys(tfMap map[string]interface{}, keys ...string) map[string]interface{} {
+	m := make(map[string]interface{}, len(tfMap))
+
+	for k, v := range tfMap {
+		m[k] = v
+	}
+
+	for _, k := range keys {
+		delete(m, k)
+	}
+
+	return m
+}
+
+func startFlow(ctx context.Context, conn *mediaconnect.MediaConnect, timeout time.Duration, arn string) error {
+	_, err := conn.StartFlowWithContext(ctx, &mediaconnect.StartFlowInput{
+		FlowArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("starting MediaConnect Flow (%s): %s", arn, err)
+	}
+
+	if _, err := waitFlowStarted(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for MediaConnect Flow (%s) start: %s", arn, err)
+	}
+
+	return nil
+}
+
+func stopFlow(ctx context.Context, conn *mediaconnect.MediaConnect, timeout time.Duration, arn string) error {
+	_, err := conn.StopFlowWithContext(ctx, &mediaconnect.StopFlowInput{
+		FlowArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("stopping MediaConnect Flow (%s): %s", arn, err)
+	}
+
+	if _, err := waitFlowStopped(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for MediaConnect Flow (%s) stop: %s", arn, err)
+	}
+
+	return nil
+}
+
+func FindFlowByARN(ctx context.Context, conn *mediaconnect.MediaConnect, arn string) (*mediaconnect.Flow, error) {
+	input := &mediaconnect.DescribeFlowInput{
+		FlowArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeFlowWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Flow == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Flow, nil
+}
+
+func statusFlow(ctx context.Context, conn *mediaconnect.MediaConnect, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFlowByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitFlowCreated(ctx context.Context, conn *mediaconnect.MediaConnect, arn string, timeout time.Duration) (*mediaconnect.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{mediaconnect.StatusUpdating},
+		Target:  []string{mediaconnect.StatusStandby},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowUpdated(ctx context.Context, conn *mediaconnect.MediaConnect, arn string, timeout time.Duration) (*mediaconnect.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{mediaconnect.StatusUpdating},
+		Target:  []string{mediaconnect.StatusStandby, mediaconnect.StatusActive},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowStarted(ctx context.Context, conn *mediaconnect.MediaConnect, arn string, timeout time.Duration) (*mediaconnect.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{mediaconnect.StatusStarting, mediaconnect.StatusStandby},
+		Target:  []string{mediaconnect.StatusActive},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowStopped(ctx context.Context, conn *mediaconnect.MediaConnect, arn string, timeout time.Duration) (*mediaconnect.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{mediaconnect.StatusStopping, mediaconnect.StatusActive},
+		Target:  []string{mediaconnect.StatusStandby},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowDeleted(ctx context.Context, conn *mediaconnect.MediaConnect, arn string, timeout time.Duration) (*mediaconnect.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{mediaconnect.StatusDeleting, mediaconnect.StatusStandby},
+		Target:  []string{},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func flattenEntitlements(apiObjects []*mediaconnect.Entitlement) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenEntitlement(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenOutputs(apiObjects []*mediaconnect.Output, include map[string]bool, all bool) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if !all && !include[aws.StringValue(apiObject.Name)] {
+			continue
+		}
+
+		tfList = append(tfList, flattenOutput(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenSources(apiObjects []*mediaconnect.Source, include map[string]bool, all bool) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if !all && !include[aws.StringValue(apiObject.Name)] {
+			continue
+		}
+
+		tfList = append(tfList, flattenSource(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenVPCInterfaces(apiObjects []*mediaconnect.VpcInterface) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenVPCInterface(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_mediaconnect_flow_output", name="Flow Output")
+func ResourceFlowOutput() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFlowOutputCreate,
+		ReadWithoutTimeout:   resourceFlowOutputRead,
+		UpdateWithoutTimeout: resourceFlowOutputUpdate,
+		DeleteWithoutTimeout: resourceFlowOutputDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			s := outputSchema()
+
+			s["flow_arn"] = &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			}
+			s["name"].ForceNew = true
+
+			return s
+		},
+	}
+}
+
+const (
+	FlowOutputIDPartCount = 2
+)
+
+func resourceFlowOutputCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	flowARN := d.Get("flow_arn").(string)
+	name := d.Get("name").(string)
+	input := &mediaconnect.AddFlowOutputsInput{
+		FlowArn: aws.String(flowARN),
+		Outputs: []*mediaconnect.AddOutputRequest{expandAddOutputRequest(flowOutputMap(d))},
+	}
+
+	output, err := conn.AddFlowOutputsWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating MediaConnect Flow (%s) Output (%s): %s", flowARN, name, err)
+	}
+
+	if len(output.Outputs) == 0 {
+		return diag.Errorf("creating MediaConnect Flow (%s) Output (%s): empty result", flowARN, name)
+	}
+
+	id, err := flex.FlattenResourceId([]string{flowARN, aws.StringValue(output.Outputs[0].OutputArn)}, FlowOutputIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitFlowUpdated(ctx, conn, flowARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for MediaConnect Flow (%s) update: %s", flowARN, err)
+	}
+
+	return resourceFlowOutputRead(ctx, d, meta)
+}
+
+func resourceFlowOutputRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), FlowOutputIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flowARN, outputARN := parts[0], parts[1]
+	output, err := FindFlowOutputByTwoPartKey(ctx, conn, flowARN, outputARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaConnect Flow Output (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading MediaConnect Flow Output (%s): %s", d.Id(), err)
+	}
+
+	d.Set("flow_arn", flowARN)
+	for k, v := range flattenOutput(output) {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("setting %s: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceFlowOutputUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), FlowOutputIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flowARN, outputARN := parts[0], parts[1]
+	input := expandUpdateFlowOutputInput(flowOutputMap(d))
+	input.FlowArn = aws.String(flowARN)
+	input.OutputArn = aws.String(outputARN)
+
+	_, err = conn.UpdateFlowOutputWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating MediaConnect Flow Output (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFlowUpdated(ctx, conn, flowARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("waiting for MediaConnect Flow (%s) update: %s", flowARN, err)
+	}
+
+	return resourceFlowOutputRead(ctx, d, meta)
+}
+
+func resourceFlowOutputDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), FlowOutputIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flowARN, outputARN := parts[0], parts[1]
+
+	log.Printf("[INFO] Deleting MediaConnect Flow Output: %s", d.Id())
+	_, err = conn.RemoveFlowOutputWithContext(ctx, &mediaconnect.RemoveFlowOutputInput{
+		FlowArn:   aws.String(flowARN),
+		OutputArn: aws.String(outputARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting MediaConnect Flow Output (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFlowUpdated(ctx, conn, flowARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for MediaConnect Flow (%s) update: %s", flowARN, err)
+	}
+
+	return nil
+}
+
+// flowOutputMap returns the resource's configuration in the form used by the flow's output blocks.
+func flowOutputMap(d *schema.ResourceData) map[string]interface{} {
+	tfMap := make(map[string]interface{})
+
+	for k := range outputSchema() {
+		tfMap[k] = d.Get(k)
+	}
+
+	return tfMap
+}
+
+func FindFlowOutputByTwoPartKey(ctx context.Context, conn *mediaconnect.MediaConnect, flowARN, outputARN string) (*mediaconnect.Output, error) {
+	flow, err := FindFlowByARN(ctx, conn, flowARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range flow.Outputs {
+		if aws.StringValue(v.OutputArn) == outputARN {
+			return v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{}
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfmediaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMediaConnectFlowOutput_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow_output.test"
+	flowResourceName := "aws_mediaconnect_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowOutputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowOutputConfig_basic(rName, 5010),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowOutputExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "destination", "192.0.2.12"),
+					resource.TestCheckResourceAttrPair(resourceName, "flow_arn", flowResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", "output1"),
+					resource.TestCheckResourceAttr(resourceName, "port", "5010"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "rtp"),
+					resource.TestCheckResourceAttr(flowResourceName, "output.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowOutputConfig_basic(rName, 5020),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowOutputExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "port", "5020"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlowOutput_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow_output.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowOutputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowOutputConfig_basic(rName, 5010),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowOutputExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediaconnect.ResourceFlowOutput(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFlowOutputExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaConnect Flow Output ID is set")
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, tfmediaconnect.FlowOutputIDPartCount, false)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectConn(ctx)
+
+		_, err = tfmediaconnect.FindFlowOutputByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		return err
+	}
+}
+
+func testAccCheckFlowOutputDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediaconnect_flow_output" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, tfmediaconnect.FlowOutputIDPartCount, false)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfmediaconnect.FindFlowOutputByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaConnect Flow Output %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccFlowOutputConfig_basic(rName string, port int) string {
+	return acctest.ConfigCompose(testAccFlowConfig_basic(rName), fmt.Sprintf(`
+resource "aws_mediaconnect_flow_output" "test" {
+  flow_arn    = aws_mediaconnect_flow.test.arn
+  name        = "output1"
+  protocol    = "rtp"
+  destination = "192.0.2.12"
+  port        = %[1]d
+}
+`, port))
+}
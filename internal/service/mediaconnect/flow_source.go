@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_mediaconnect_flow_source", name="Flow Source")
+func ResourceFlowSource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFlowSourceCreate,
+		ReadWithoutTimeout:   resourceFlowSourceRead,
+		UpdateWithoutTimeout: resourceFlowSourceUpdate,
+		DeleteWithoutTimeout: resourceFlowSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			s := sourceSchema()
+
+			s["flow_arn"] = &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			}
+			s["name"].ForceNew = true
+
+			return s
+		},
+	}
+}
+
+const (
+	FlowSourceIDPartCount = 2
+)
+
+func resourceFlowSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	flowARN := d.Get("flow_arn").(string)
+	name := d.Get("name").(string)
+	input := &mediaconnect.AddFlowSourcesInput{
+		FlowArn: aws.String(flowARN),
+		Sources: []*mediaconnect.SetSourceRequest{expandSetSourceRequest(flowSourceMap(d))},
+	}
+
+	output, err := conn.AddFlowSourcesWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating MediaConnect Flow (%s) Source (%s): %s", flowARN, name, err)
+	}
+
+	if len(output.Sources) == 0 {
+		return diag.Errorf("creating MediaConnect Flow (%s) Source (%s): empty result", flowARN, name)
+	}
+
+	id, err := flex.FlattenResourceId([]string{flowARN, aws.StringValue(output.Sources[0].SourceArn)}, FlowSourceIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitFlowUpdated(ctx, conn, flowARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for MediaConnect Flow (%s) update: %s", flowARN, err)
+	}
+
+	return resourceFlowSourceRead(ctx, d, meta)
+}
+
+func resourceFlowSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), FlowSourceIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flowARN, sourceARN := parts[0], parts[1]
+	source, err := FindFlowSourceByTwoPartKey(ctx, conn, flowARN, sourceARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaConnect Flow Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading MediaConnect Flow Source (%s): %s", d.Id(), err)
+	}
+
+	d.Set("flow_arn", flowARN)
+	for k, v := range flattenSource(source) {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("setting %s: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceFlowSourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), FlowSourceIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flowARN, sourceARN := parts[0], parts[1]
+	input := expandUpdateFlowSourceInput(flowSourceMap(d))
+	input.FlowArn = aws.String(flowARN)
+	input.SourceArn = aws.String(sourceARN)
+
+	_, err = conn.UpdateFlowSourceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating MediaConnect Flow Source (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFlowUpdated(ctx, conn, flowARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("waiting for MediaConnect Flow (%s) update: %s", flowARN, err)
+	}
+
+	return resourceFlowSourceRead(ctx, d, meta)
+}
+
+func resourceFlowSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), FlowSourceIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flowARN, sourceARN := parts[0], parts[1]
+
+	log.Printf("[INFO] Deleting MediaConnect Flow Source: %s", d.Id())
+	_, err = conn.RemoveFlowSourceWithContext(ctx, &mediaconnect.RemoveFlowSourceInput{
+		FlowArn:   aws.String(flowARN),
+		SourceArn: aws.String(sourceARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting MediaConnect Flow Source (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFlowUpdated(ctx, conn, flowARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for MediaConnect Flow (%s) update: %s", flowARN, err)
+	}
+
+	return nil
+}
+
+// flowSourceMap returns the resource's configuration in the form used by the flow's source blocks.
+func flowSourceMap(d *schema.ResourceData) map[string]interface{} {
+	tfMap := make(map[string]interface{})
+
+	for k := range sourceSchema() {
+		tfMap[k] = d.Get(k)
+	}
+
+	return tfMap
+}
+
+func FindFlowSourceByTwoPartKey(ctx context.Context, conn *mediaconnect.MediaConnect, flowARN, sourceARN string) (*mediaconnect.Source, error) {
+	flow, err := FindFlowByARN(ctx, conn, flowARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sources := flow.Sources
+	if len(sources) == 0 && flow.Source != nil {
+		sources = []*mediaconnect.Source{flow.Source}
+	}
+
+	for _, v := range sources {
+		if aws.StringValue(v.SourceArn) == sourceARN {
+			return v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{}
+}
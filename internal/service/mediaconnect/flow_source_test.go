@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfmediaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMediaConnectFlowSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow_source.test"
+	flowResourceName := "aws_mediaconnect_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowSourceConfig_basic(rName, "first description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowSourceExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "description", "first description"),
+					resource.TestCheckResourceAttrPair(resourceName, "flow_arn", flowResourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "ingest_ip"),
+					resource.TestCheckResourceAttr(resourceName, "ingest_port", "5002"),
+					resource.TestCheckResourceAttr(resourceName, "name", "source2"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "rtp-fec"),
+					resource.TestCheckResourceAttr(flowResourceName, "source.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowSourceConfig_basic(rName, "second description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowSourceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "second description"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlowSource_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow_source.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowSourceConfig_basic(rName, "first description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowSourceExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediaconnect.ResourceFlowSource(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFlowSourceExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaConnect Flow Source ID is set")
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, tfmediaconnect.FlowSourceIDPartCount, false)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectConn(ctx)
+
+		_, err = tfmediaconnect.FindFlowSourceByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		return err
+	}
+}
+
+func testAccCheckFlowSourceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediaconnect_flow_source" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, tfmediaconnect.FlowSourceIDPartCount, false)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfmediaconnect.FindFlowSourceByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaConnect Flow Source %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccFlowSourceConfig_basic(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source1"
+    protocol       = "rtp-fec"
+    ingest_port    = 5000
+    whitelist_cidr = "10.24.34.0/23"
+  }
+
+  source_failover_config {
+    failover_mode = "FAILOVER"
+    state         = "ENABLED"
+  }
+}
+
+resource "aws_mediaconnect_flow_source" "test" {
+  flow_arn       = aws_mediaconnect_flow.test.arn
+  name           = "source2"
+  description    = %[2]q
+  protocol       = "rtp-fec"
+  ingest_port    = 5002
+  whitelist_cidr = "10.24.36.0/23"
+}
+`, rName, description)
+}
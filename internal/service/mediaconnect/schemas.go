@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func encryptionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"algorithm": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(mediaconnect.Algorithm_Values(), false),
+				},
+				"constant_initialization_vector": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"device_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"key_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(mediaconnect.KeyType_Values(), false),
+				},
+				"region": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"resource_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"secret_arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+// outputSchema returns the attributes shared by the flow's output blocks and aws_mediaconnect_flow_output.
+func outputSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cidr_allow_list": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: verify.ValidCIDRNetworkAddress,
+			},
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"destination": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"encryption": encryptionSchema(),
+		"max_latency": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"min_latency": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"port": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IsPortNumber,
+		},
+		"protocol": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(mediaconnect.Protocol_Values(), false),
+		},
+		"remote_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"sender_control_port": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IsPortNumber,
+		},
+		"smoothing_latency": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"stream_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"vpc_interface_attachment": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"vpc_interface_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// sourceSchema returns the attributes shared by the flow's source blocks and aws_mediaconnect_flow_source.
+func sourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"decryption": encryptionSchema(),
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"entitlement_arn": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidARN,
+		},
+		"ingest_ip": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ingest_port": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IsPortNumber,
+		},
+		"max_bitrate": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"max_latency": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"max_sync_buffer": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"min_latency": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"protocol": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(mediaconnect.Protocol_Values(), false),
+		},
+		"sender_control_port": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IsPortNumber,
+		},
+		"sender_ip_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+		"source_listener_address": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"source_listener_port": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IsPortNumber,
+		},
+		"stream_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"vpc_interface_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"whitelist_cidr": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidCIDRNetworkAddress,
+		},
+	}
+}
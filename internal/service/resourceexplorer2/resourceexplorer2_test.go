@@ -13,12 +13,19 @@ func TestAccResourceExplorer2_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"DefaultViewAssociation": {
+			"basic":      testAccDefaultViewAssociation_basic,
+			"disappears": testAccDefaultViewAssociation_disappears,
+		},
 		"Index": {
 			"basic":      testAccIndex_basic,
 			"disappears": testAccIndex_disappears,
 			"tags":       testAccIndex_tags,
 			"type":       testAccIndex_type,
 		},
+		"SearchDataSource": {
+			"basic": testAccSearchDataSource_basic,
+		},
 		"View": {
 			"basic":       testAccView_basic,
 			"defaultView": testAccView_defaultView,
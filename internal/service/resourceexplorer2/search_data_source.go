@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceexplorer2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourceexplorer2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Search")
+func newDataSourceSearch(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceSearch{}, nil
+}
+
+type dataSourceSearch struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceSearch) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_resourceexplorer2_search"
+}
+
+func (d *dataSourceSearch) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"query_string": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1280),
+				},
+			},
+			"resource_count": schema.ListAttribute{
+				ElementType: types.ObjectType{
+					AttrTypes: flex.AttributeTypesMust[searchResourceCountData](ctx),
+				},
+				Computed: true,
+			},
+			"resources": schema.ListAttribute{
+				ElementType: types.ObjectType{
+					AttrTypes: searchResourceAttrTypes,
+				},
+				Computed: true,
+			},
+			"view_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceSearch) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceSearchData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ResourceExplorer2Client(ctx)
+
+	input := &resourceexplorer2.SearchInput{
+		QueryString: flex.StringFromFramework(ctx, data.QueryString),
+	}
+
+	if !data.ViewARN.IsNull() {
+		input.ViewArn = flex.ARNStringFromFramework(ctx, data.ViewARN)
+	}
+
+	count, resources, err := findSearchResults(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("searching Resource Explorer", err.Error())
+
+		return
+	}
+
+	if data.ViewARN.IsNull() {
+		data.ID = types.StringValue(data.QueryString.ValueString())
+	} else {
+		data.ID = types.StringValue(fmt.Sprintf("%s,%s", data.ViewARN.ValueARN().String(), data.QueryString.ValueString()))
+	}
+	data.ResourceCount = d.flattenResourceCount(ctx, count)
+
+	resourcesList, err := d.flattenResources(ctx, resources)
+
+	if err != nil {
+		response.Diagnostics.AddError("flattening Resource Explorer search results", err.Error())
+
+		return
+	}
+
+	data.Resources = resourcesList
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (d *dataSourceSearch) flattenResourceCount(ctx context.Context, apiObject *awstypes.ResourceCount) types.List {
+	attributeTypes := flex.AttributeTypesMust[searchResourceCountData](ctx)
+	elementType := types.ObjectType{AttrTypes: attributeTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elementType)
+	}
+
+	return types.ListValueMust(elementType, []attr.Value{
+		types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+			"complete":        flex.BoolToFramework(ctx, apiObject.Complete),
+			"total_resources": flex.Int64ToFramework(ctx, apiObject.TotalResources),
+		}),
+	})
+}
+
+func (d *dataSourceSearch) flattenResources(ctx context.Context, apiObjects []awstypes.Resource) (types.List, error) {
+	attributeTypes := searchResourceAttrTypes
+	elementType := types.ObjectType{AttrTypes: attributeTypes}
+	elements := make([]attr.Value, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		properties, err := d.flattenResourceProperties(ctx, apiObject.Properties)
+
+		if err != nil {
+			return types.ListNull(elementType), err
+		}
+
+		elements = append(elements, types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+			"arn":               flex.StringToFramework(ctx, apiObject.Arn),
+			"last_reported_at":  timeToFramework(apiObject.LastReportedAt),
+			"owning_account_id": flex.StringToFramework(ctx, apiObject.OwningAccountId),
+			"region":            flex.StringToFramework(ctx, apiObject.Region),
+			"resource_property": properties,
+			"resource_type":     flex.StringToFramework(ctx, apiObject.ResourceType),
+			"service":           flex.StringToFramework(ctx, apiObject.Service),
+		}))
+	}
+
+	return types.ListValueMust(elementType, elements), nil
+}
+
+func (d *dataSourceSearch) flattenResourceProperties(ctx context.Context, apiObjects []awstypes.ResourceProperty) (types.List, error) {
+	attributeTypes := searchResourcePropertyAttrTypes
+	elementType := types.ObjectType{AttrTypes: attributeTypes}
+	elements := make([]attr.Value, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		data := types.StringNull()
+
+		if apiObject.Data != nil {
+			v, err := apiObject.Data.MarshalSmithyDocument()
+
+			if err != nil {
+				return types.ListNull(elementType), err
+			}
+
+			data = types.StringValue(string(v))
+		}
+
+		elements = append(elements, types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+			"data":             data,
+			"last_reported_at": timeToFramework(apiObject.LastReportedAt),
+			"name":             flex.StringToFramework(ctx, apiObject.Name),
+		}))
+	}
+
+	return types.ListValueMust(elementType, elements), nil
+}
+
+func timeToFramework(v *time.Time) types.String {
+	if v == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(aws.ToTime(v).Format(time.RFC3339))
+}
+
+func findSearchResults(ctx context.Context, conn *resourceexplorer2.Client, input *resourceexplorer2.SearchInput) (*awstypes.ResourceCount, []awstypes.Resource, error) {
+	var count *awstypes.ResourceCount
+	var resources []awstypes.Resource
+
+	pages := resourceexplorer2.NewSearchPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// The count is included in every page.
+		count = page.Count
+		resources = append(resources, page.Resources...)
+	}
+
+	return count, resources, nil
+}
+
+type dataSourceSearchData struct {
+	ID            types.String `tfsdk:"id"`
+	QueryString   types.String `tfsdk:"query_string"`
+	ResourceCount types.List   `tfsdk:"resource_count"`
+	Resources     types.List   `tfsdk:"resources"`
+	ViewARN       fwtypes.ARN  `tfsdk:"view_arn"`
+}
+
+type searchResourceCountData struct {
+	Complete       types.Bool  `tfsdk:"complete"`
+	TotalResources types.Int64 `tfsdk:"total_resources"`
+}
+
+var searchResourcePropertyAttrTypes = map[string]attr.Type{
+	"data":             types.StringType,
+	"last_reported_at": types.StringType,
+	"name":             types.StringType,
+}
+
+var searchResourceAttrTypes = map[string]attr.Type{
+	"arn":               types.StringType,
+	"last_reported_at":  types.StringType,
+	"owning_account_id": types.StringType,
+	"region":            types.StringType,
+	"resource_property": types.ListType{ElemType: types.ObjectType{AttrTypes: searchResourcePropertyAttrTypes}},
+	"resource_type":     types.StringType,
+	"service":           types.StringType,
+}
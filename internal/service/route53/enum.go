@@ -22,3 +22,26 @@ const (
 	TrafficPolicyInstanceStateFailed   = "Failed"
 	TrafficPolicyInstanceStateUpdating = "Updating"
 )
+
+// Routing policies of resource record sets, named as the corresponding aws_route53_record arguments.
+const (
+	routingPolicyCIDR             = "cidr"
+	routingPolicyFailover         = "failover"
+	routingPolicyGeolocation      = "geolocation"
+	routingPolicyLatency          = "latency"
+	routingPolicyMultivalueAnswer = "multivalue_answer"
+	routingPolicySimple           = "simple"
+	routingPolicyWeighted         = "weighted"
+)
+
+func routingPolicy_Values() []string {
+	return []string{
+		routingPolicyCIDR,
+		routingPolicyFailover,
+		routingPolicyGeolocation,
+		routingPolicyLatency,
+		routingPolicyMultivalueAnswer,
+		routingPolicySimple,
+		routingPolicyWeighted,
+	}
+}
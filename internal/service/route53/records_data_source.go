@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"golang.org/x/exp/slices"
+)
+
+// @SDKDataSource("aws_route53_records")
+func DataSourceRecords() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRecordsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"resource_record_sets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"evaluate_target_health": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"zone_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"cidr_routing_policy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"collection_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"location_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"failover": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"geolocation": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"continent": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"country": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"subdivision": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"health_check_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"multivalue_answer": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"routing_policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"set_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"traffic_policy_instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"weight": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"routing_policies": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(routingPolicy_Values(), false),
+				},
+			},
+			"types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+				},
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	var routingPolicies, types []string
+	if v, ok := d.GetOk("routing_policies"); ok {
+		routingPolicies = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("types"); ok {
+		types = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	var recordSets []*route53.ResourceRecordSet
+
+	err := conn.ListResourceRecordSetsPagesWithContext(ctx, input, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceRecordSets {
+			if v == nil {
+				continue
+			}
+
+			if nameRegex != nil && !nameRegex.MatchString(recordSetName(v)) {
+				continue
+			}
+
+			if len(types) > 0 && !slices.Contains(types, aws.StringValue(v.Type)) {
+				continue
+			}
+
+			if len(routingPolicies) > 0 && !slices.Contains(routingPolicies, recordSetRoutingPolicy(v)) {
+				continue
+			}
+
+			recordSets = append(recordSets, v)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Route 53 Records (%s): %s", zoneID, err)
+	}
+
+	d.SetId(zoneID)
+	if err := d.Set("resource_record_sets", flattenResourceRecordSets(recordSets)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resource_record_sets: %s", err)
+	}
+
+	return diags
+}
+
+func flattenResourceRecordSets(apiObjects []*route53.ResourceRecordSet) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		typ := aws.StringValue(apiObject.Type)
+		tfMap := map[string]interface{}{
+			"failover":                   aws.StringValue(apiObject.Failover),
+			"health_check_id":            aws.StringValue(apiObject.HealthCheckId),
+			"multivalue_answer":          aws.BoolValue(apiObject.MultiValueAnswer),
+			"name":                       recordSetName(apiObject),
+			"records":                    FlattenResourceRecords(apiObject.ResourceRecords, typ),
+			"region":                     aws.StringValue(apiObject.Region),
+			"routing_policy":             recordSetRoutingPolicy(apiObject),
+			"set_identifier":             aws.StringValue(apiObject.SetIdentifier),
+			"traffic_policy_instance_id": aws.StringValue(apiObject.TrafficPolicyInstanceId),
+			"ttl":                        aws.Int64Value(apiObject.TTL),
+			"type":                       typ,
+			"weight":                     aws.Int64Value(apiObject.Weight),
+		}
+
+		if v := apiObject.AliasTarget; v != nil {
+			tfMap["alias"] = []interface{}{map[string]interface{}{
+				"evaluate_target_health": aws.BoolValue(v.EvaluateTargetHealth),
+				"name":                   NormalizeAliasName(aws.StringValue(v.DNSName)),
+				"zone_id":                aws.StringValue(v.HostedZoneId),
+			}}
+		}
+
+		if v := apiObject.CidrRoutingConfig; v != nil {
+			tfMap["cidr_routing_policy"] = []interface{}{map[string]interface{}{
+				"collection_id": aws.StringValue(v.CollectionId),
+				"location_name": aws.StringValue(v.LocationName),
+			}}
+		}
+
+		if v := apiObject.GeoLocation; v != nil {
+			tfMap["geolocation"] = []interface{}{map[string]interface{}{
+				"continent":   aws.StringValue(v.ContinentCode),
+				"country":     aws.StringValue(v.CountryCode),
+				"subdivision": aws.StringValue(v.SubdivisionCode),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+// recordSetName returns the name of the resource record set without the trailing dot and with special characters
+// unescaped, as in the fqdn attribute of aws_route53_record.
+func recordSetName(apiObject *route53.ResourceRecordSet) string {
+	return strings.TrimSuffix(CleanRecordName(aws.StringValue(apiObject.Name)), ".")
+}
+
+// recordSetRoutingPolicy returns the routing policy of the resource record set.
+func recordSetRoutingPolicy(apiObject *route53.ResourceRecordSet) string {
+	switch {
+	case apiObject.CidrRoutingConfig != nil:
+		return routingPolicyCIDR
+	case apiObject.Failover != nil:
+		return routingPolicyFailover
+	case apiObject.GeoLocation != nil:
+		return routingPolicyGeolocation
+	case apiObject.Region != nil:
+		return routingPolicyLatency
+	case aws.BoolValue(apiObject.MultiValueAnswer):
+		return routingPolicyMultivalueAnswer
+	case apiObject.Weight != nil:
+		return routingPolicyWeighted
+	default:
+		return routingPolicySimple
+	}
+}
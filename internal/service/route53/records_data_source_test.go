@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRoute53RecordsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	zoneName := acctest.RandomDomain()
+	dataSourceName := "data.aws_route53_records.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsDataSourceConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "aws_route53_zone.test", "zone_id"),
+					// NS, SOA and the three records.
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", "5"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resource_record_sets.*", map[string]string{
+						"name":           "www." + zoneName.String(),
+						"records.#":      "1",
+						"records.0":      "127.0.0.1",
+						"routing_policy": "weighted",
+						"set_identifier": "blue",
+						"ttl":            "30",
+						"type":           "A",
+						"weight":         "90",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resource_record_sets.*", map[string]string{
+						"name":           "www." + zoneName.String(),
+						"records.0":      "127.0.0.2",
+						"routing_policy": "weighted",
+						"set_identifier": "green",
+						"weight":         "10",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resource_record_sets.*", map[string]string{
+						"alias.#":        "1",
+						"alias.0.name":   "www." + zoneName.String(),
+						"name":           "api." + zoneName.String(),
+						"records.#":      "0",
+						"routing_policy": "simple",
+						"type":           "A",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordsDataSource_filters(t *testing.T) {
+	ctx := acctest.Context(t)
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsDataSourceConfig_filters(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_route53_records.name_regex", "resource_record_sets.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_route53_records.name_regex", "resource_record_sets.0.name", "api."+zoneName.String()),
+					resource.TestCheckResourceAttr("data.aws_route53_records.routing_policies", "resource_record_sets.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_route53_records.routing_policies", "resource_record_sets.0.routing_policy", "weighted"),
+					resource.TestCheckResourceAttr("data.aws_route53_records.routing_policies", "resource_record_sets.1.routing_policy", "weighted"),
+					resource.TestCheckResourceAttr("data.aws_route53_records.types", "resource_record_sets.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.aws_route53_records.types", "resource_record_sets.*", map[string]string{
+						"type": "NS",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.aws_route53_records.types", "resource_record_sets.*", map[string]string{
+						"type": "SOA",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecordsDataSourceConfig_base(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_record" "blue" {
+  zone_id        = aws_route53_zone.test.zone_id
+  name           = "www"
+  type           = "A"
+  ttl            = 30
+  records        = ["127.0.0.1"]
+  set_identifier = "blue"
+
+  weighted_routing_policy {
+    weight = 90
+  }
+}
+
+resource "aws_route53_record" "green" {
+  zone_id        = aws_route53_zone.test.zone_id
+  name           = "www"
+  type           = "A"
+  ttl            = 30
+  records        = ["127.0.0.2"]
+  set_identifier = "green"
+
+  weighted_routing_policy {
+    weight = 10
+  }
+}
+
+resource "aws_route53_record" "alias" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "api"
+  type    = "A"
+
+  alias {
+    name                   = aws_route53_record.blue.fqdn
+    zone_id                = aws_route53_zone.test.zone_id
+    evaluate_target_health = false
+  }
+}
+`, zoneName)
+}
+
+func testAccRecordsDataSourceConfig_basic(zoneName string) string {
+	return acctest.ConfigCompose(testAccRecordsDataSourceConfig_base(zoneName), `
+data "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  depends_on = [aws_route53_record.alias, aws_route53_record.blue, aws_route53_record.green]
+}
+`)
+}
+
+func testAccRecordsDataSourceConfig_filters(zoneName string) string {
+	return acctest.ConfigCompose(testAccRecordsDataSourceConfig_base(zoneName), `
+data "aws_route53_records" "name_regex" {
+  zone_id    = aws_route53_zone.test.zone_id
+  name_regex = "^api\\."
+
+  depends_on = [aws_route53_record.alias, aws_route53_record.blue, aws_route53_record.green]
+}
+
+data "aws_route53_records" "routing_policies" {
+  zone_id          = aws_route53_zone.test.zone_id
+  routing_policies = ["weighted"]
+
+  depends_on = [aws_route53_record.alias, aws_route53_record.blue, aws_route53_record.green]
+}
+
+data "aws_route53_records" "types" {
+  zone_id = aws_route53_zone.test.zone_id
+  types   = ["NS", "SOA"]
+
+  depends_on = [aws_route53_record.alias, aws_route53_record.blue, aws_route53_record.green]
+}
+`)
+}
@@ -33,6 +33,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceDelegationSet,
 			TypeName: "aws_route53_delegation_set",
 		},
+		{
+			Factory:  DataSourceRecords,
+			TypeName: "aws_route53_records",
+		},
 		{
 			Factory:  DataSourceTrafficPolicyDocument,
 			TypeName: "aws_route53_traffic_policy_document",
@@ -0,0 +1,1117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	directorySyncChangeCreate = "create"
+	directorySyncChangeDelete = "delete"
+	directorySyncChangeUpdate = "update"
+
+	// directorySyncManifestName is the key, relative to the key prefix, of the object that records
+	// the source hash and ETag of each object uploaded.
+	directorySyncManifestName = ".terraform-directory-sync.json"
+
+	directorySyncUploadConcurrency = 8
+)
+
+// @SDKResource("aws_s3_directory_sync", name="Directory Sync")
+func ResourceDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectorySyncCreate,
+		ReadWithoutTimeout:   resourceDirectorySyncRead,
+		UpdateWithoutTimeout: resourceDirectorySyncUpdate,
+		DeleteWithoutTimeout: resourceDirectorySyncDelete,
+
+		CustomizeDiff: resourceDirectorySyncCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cache_control": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"changes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"content_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"delete_orphans": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"file_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"include": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validDirectorySyncKeyPrefix,
+			},
+			"manifest_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_dir": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceDirectorySyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	id := bucket + "/" + keyPrefix
+
+	if err := directorySyncApply(ctx, conn, d, true); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Directory Sync (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceDirectorySyncRead(ctx, d, meta)...)
+}
+
+func resourceDirectorySyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+
+	filter, err := expandDirectorySyncFilter(d.Get("include").(*schema.Set), d.Get("exclude").(*schema.Set))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	remote, err := findDirectorySyncObjects(ctx, conn, bucket, keyPrefix, filter)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Directory Sync (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	manifest, err := findDirectorySyncManifest(ctx, conn, bucket, keyPrefix)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	// Only the objects whose ETag is unchanged since they were uploaded contribute to the
+	// manifest hash, so that missing, modified and (optionally) orphaned objects all show up
+	// as a difference without reading the source directory.
+	managed := make(map[string]string)
+
+	for key, v := range manifest.Objects {
+		if etag, ok := remote[key]; ok && etag == v.ETag {
+			managed[key] = v.Hash
+		}
+	}
+
+	if d.Get("delete_orphans").(bool) {
+		for key, etag := range remote {
+			if _, ok := manifest.Objects[key]; !ok {
+				managed[key] = etag
+			}
+		}
+	}
+
+	d.Set("manifest_hash", directorySyncManifestHash(managed))
+
+	return diags
+}
+
+func resourceDirectorySyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+
+	if err := directorySyncApply(ctx, conn, d, false); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDirectorySyncRead(ctx, d, meta)...)
+}
+
+func resourceDirectorySyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+
+	filter, err := expandDirectorySyncFilter(d.Get("include").(*schema.Set), d.Get("exclude").(*schema.Set))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	remote, err := findDirectorySyncObjects(ctx, conn, bucket, keyPrefix, filter)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	var keys []string
+
+	if d.Get("delete_orphans").(bool) {
+		// Everything matching the filters under the prefix is owned by this resource.
+		for key := range remote {
+			keys = append(keys, key)
+		}
+	} else {
+		manifest, err := findDirectorySyncManifest(ctx, conn, bucket, keyPrefix)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting S3 Directory Sync (%s): %s", d.Id(), err)
+		}
+
+		for key := range manifest.Objects {
+			if _, ok := remote[key]; ok {
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	sort.Strings(keys)
+	keys = append(keys, keyPrefix+directorySyncManifestName)
+
+	log.Printf("[DEBUG] Deleting S3 Directory Sync (%s): %d objects", d.Id(), len(keys))
+	if err := deleteDirectorySyncObjects(ctx, conn, bucket, keys); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceDirectorySyncCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	arguments := []string{"bucket", "cache_control", "content_types", "delete_orphans", "exclude", "include", "key_prefix", "source_dir"}
+
+	for _, key := range arguments {
+		if !d.NewValueKnown(key) {
+			return setNewComputedDirectorySync(d)
+		}
+	}
+
+	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+
+	filter, err := expandDirectorySyncFilter(d.Get("include").(*schema.Set), d.Get("exclude").(*schema.Set))
+
+	if err != nil {
+		return err
+	}
+
+	local, err := walkDirectorySyncSource(d.Get("source_dir").(string), d.Get("key_prefix").(string), filter)
+
+	if err != nil {
+		return err
+	}
+
+	plan, err := newDirectorySyncPlan(ctx, conn, d, local, d.Id() == "")
+
+	if err != nil {
+		return err
+	}
+
+	if d.Id() == "" || len(plan.changes) > 0 || d.Get("manifest_hash").(string) != plan.manifestHash || d.HasChanges(arguments...) {
+		if err := d.SetNew("changes", plan.changes); err != nil {
+			return err
+		}
+		if err := d.SetNew("file_count", len(local)); err != nil {
+			return err
+		}
+		if err := d.SetNew("manifest_hash", plan.manifestHash); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func setNewComputedDirectorySync(d *schema.ResourceDiff) error {
+	for _, key := range []string{"changes", "file_count", "manifest_hash"} {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// directorySyncGetter is implemented by both schema.ResourceData and schema.ResourceDiff.
+type directorySyncGetter interface {
+	Get(string) interface{}
+	GetChange(string) (interface{}, interface{})
+}
+
+type directorySyncFile struct {
+	cacheControl string
+	contentType  string
+	hash         string
+	key          string
+	path         string
+	rel          string
+}
+
+type directorySyncPlan struct {
+	changes      map[string]string
+	deletes      []string
+	manifestHash string
+	remote       map[string]string
+	uploads      []*directorySyncFile
+}
+
+// directorySyncManifest is stored as JSON under the key prefix. It records, for each object uploaded,
+// the hash of the source file and the ETag S3 returned, so that objects whose ETag isn't the hash of
+// their content (e.g. SSE-KMS objects) can be compared without reading each object's metadata.
+type directorySyncManifest struct {
+	Objects map[string]directorySyncManifestObject `json:"objects"`
+}
+
+type directorySyncManifestObject struct {
+	ETag string `json:"etag"`
+	Hash string `json:"hash"`
+}
+
+// current reports whether the object was uploaded from a file with the specified hash.
+// The hash is the ETag S3 reports for unencrypted and SSE-S3 objects. Otherwise the
+// ETag recorded in the manifest on upload is used.
+func (m *directorySyncManifest) current(key, etag, hash string) bool {
+	if etag == hash {
+		return true
+	}
+
+	v, ok := m.Objects[key]
+
+	return ok && v.ETag == etag && v.Hash == hash
+}
+
+// newDirectorySyncPlan compares the local files against the objects in the bucket.
+// When replaceAll is true every local file is uploaded, e.g. on creation.
+func newDirectorySyncPlan(ctx context.Context, conn *s3.S3, d directorySyncGetter, local []*directorySyncFile, replaceAll bool) (*directorySyncPlan, error) {
+	filter, err := expandDirectorySyncFilter(d.Get("include").(*schema.Set), d.Get("exclude").(*schema.Set))
+
+	if err != nil {
+		return nil, err
+	}
+
+	o, n := d.GetChange("content_types")
+	oldContentTypes, newContentTypes := expandDirectorySyncContentTypes(o.(map[string]interface{})), expandDirectorySyncContentTypes(n.(map[string]interface{}))
+	o, n = d.GetChange("cache_control")
+	oldCacheControl, err := expandDirectorySyncCacheControlRules(o.([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	newCacheControl, err := expandDirectorySyncCacheControlRules(n.([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	remote, err := findDirectorySyncObjects(ctx, conn, bucket, keyPrefix, filter)
+
+	switch {
+	case tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) && replaceAll:
+		// The bucket may be created in the same apply.
+		remote = make(map[string]string)
+	case err != nil:
+		return nil, err
+	}
+
+	manifest := &directorySyncManifest{}
+
+	if !replaceAll {
+		manifest, err = findDirectorySyncManifest(ctx, conn, bucket, keyPrefix)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	plan := &directorySyncPlan{
+		changes: make(map[string]string),
+		remote:  remote,
+	}
+	sourceHashes := make(map[string]string, len(local))
+
+	for _, v := range local {
+		ext := strings.ToLower(path.Ext(v.rel))
+		oldContentType, oldCacheControl := resolveDirectorySyncContentType(oldContentTypes, ext, v.contentType), oldCacheControl.match(v.rel)
+		v.contentType, v.cacheControl = resolveDirectorySyncContentType(newContentTypes, ext, v.contentType), newCacheControl.match(v.rel)
+		sourceHashes[v.key] = v.hash
+
+		etag, exists := remote[v.key]
+
+		if !exists {
+			plan.changes[v.key] = directorySyncChangeCreate
+			plan.uploads = append(plan.uploads, v)
+			continue
+		}
+
+		if replaceAll || oldContentType != v.contentType || oldCacheControl != v.cacheControl || !manifest.current(v.key, etag, v.hash) {
+			plan.changes[v.key] = directorySyncChangeUpdate
+			plan.uploads = append(plan.uploads, v)
+		}
+	}
+
+	if d.Get("delete_orphans").(bool) {
+		for key := range remote {
+			if _, ok := sourceHashes[key]; !ok {
+				plan.changes[key] = directorySyncChangeDelete
+				plan.deletes = append(plan.deletes, key)
+			}
+		}
+		sort.Strings(plan.deletes)
+	}
+
+	plan.manifestHash = directorySyncManifestHash(sourceHashes)
+
+	return plan, nil
+}
+
+// directorySyncApply uploads and deletes objects so that the bucket matches the source directory,
+// records the source hashes in the manifest object and sets the changes made.
+func directorySyncApply(ctx context.Context, conn *s3.S3, d *schema.ResourceData, replaceAll bool) error {
+	filter, err := expandDirectorySyncFilter(d.Get("include").(*schema.Set), d.Get("exclude").(*schema.Set))
+
+	if err != nil {
+		return err
+	}
+
+	sourceDir, keyPrefix := d.Get("source_dir").(string), d.Get("key_prefix").(string)
+	local, err := walkDirectorySyncSource(sourceDir, keyPrefix, filter)
+
+	if err != nil {
+		return err
+	}
+
+	plan, err := newDirectorySyncPlan(ctx, conn, d, local, replaceAll)
+
+	if err != nil {
+		return err
+	}
+
+	// The source directory is hashed again on apply. Don't apply anything other than the planned
+	// changes if it, or the objects in the bucket, changed after the plan was created.
+	if !directorySyncPlanned(d, plan) {
+		return fmt.Errorf("source_dir (%s) or the objects in the bucket changed after the plan was created, plan again", sourceDir)
+	}
+
+	bucket := d.Get("bucket").(string)
+	etags, err := uploadDirectorySyncFiles(ctx, conn, bucket, plan.uploads)
+
+	if err != nil {
+		return err
+	}
+
+	if err := deleteDirectorySyncObjects(ctx, conn, bucket, plan.deletes); err != nil {
+		return err
+	}
+
+	manifest := &directorySyncManifest{
+		Objects: make(map[string]directorySyncManifestObject, len(local)),
+	}
+
+	for _, v := range local {
+		etag, ok := etags[v.key]
+
+		if !ok {
+			etag = plan.remote[v.key]
+		}
+
+		manifest.Objects[v.key] = directorySyncManifestObject{
+			ETag: etag,
+			Hash: v.hash,
+		}
+	}
+
+	if err := putDirectorySyncManifest(ctx, conn, bucket, keyPrefix, manifest); err != nil {
+		return err
+	}
+
+	d.Set("changes", plan.changes)
+	d.Set("file_count", len(local))
+	d.Set("manifest_hash", plan.manifestHash)
+
+	return nil
+}
+
+// directorySyncPlanned reports whether the plan matches the planned values of changes and manifest_hash.
+// The values are unknown at plan time if any of the arguments were, e.g. when the bucket is created in the same apply.
+func directorySyncPlanned(d *schema.ResourceData, plan *directorySyncPlan) bool {
+	raw := d.GetRawPlan()
+
+	if raw.IsNull() || !raw.GetAttr("changes").IsWhollyKnown() || !raw.GetAttr("manifest_hash").IsWhollyKnown() {
+		return true
+	}
+
+	if d.Get("manifest_hash").(string) != plan.manifestHash {
+		return false
+	}
+
+	changes := d.Get("changes").(map[string]interface{})
+
+	if len(changes) != len(plan.changes) {
+		return false
+	}
+
+	for key, change := range plan.changes {
+		if v, ok := changes[key].(string); !ok || v != change {
+			return false
+		}
+	}
+
+	return true
+}
+
+// uploadDirectorySyncFiles uploads the files and returns the ETag of each object.
+func uploadDirectorySyncFiles(ctx context.Context, conn *s3.S3, bucket string, files []*directorySyncFile) (map[string]string, error) {
+	uploader := s3manager.NewUploaderWithClient(conn)
+	work := make(chan *directorySyncFile)
+	etags := make(map[string]string, len(files))
+	var mu sync.Mutex
+	var uploadErrs *multierror.Error
+	var wg sync.WaitGroup
+
+	for i := 0; i < directorySyncUploadConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for v := range work {
+				etag, err := uploadDirectorySyncFile(ctx, uploader, bucket, v)
+
+				mu.Lock()
+				if err != nil {
+					uploadErrs = multierror.Append(uploadErrs, err)
+				} else {
+					etags[v.key] = etag
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, v := range files {
+		work <- v
+	}
+	close(work)
+	wg.Wait()
+
+	return etags, uploadErrs.ErrorOrNil()
+}
+
+func uploadDirectorySyncFile(ctx context.Context, uploader *s3manager.Uploader, bucket string, v *directorySyncFile) (string, error) {
+	file, err := os.Open(v.path)
+
+	if err != nil {
+		return "", fmt.Errorf("opening S3 object source (%s): %w", v.path, err)
+	}
+
+	defer func() {
+		err := file.Close()
+		if err != nil {
+			log.Printf("[WARN] Error closing S3 object source (%s): %s", v.path, err)
+		}
+	}()
+
+	input := &s3manager.UploadInput{
+		Body:        file,
+		Bucket:      aws.String(bucket),
+		ContentType: aws.String(v.contentType),
+		Key:         aws.String(v.key),
+	}
+
+	if v.cacheControl != "" {
+		input.CacheControl = aws.String(v.cacheControl)
+	}
+
+	output, err := uploader.UploadWithContext(ctx, input)
+
+	if err != nil {
+		return "", fmt.Errorf("uploading S3 object (%s): %w", v.key, err)
+	}
+
+	return strings.Trim(aws.StringValue(output.ETag), `"`), nil
+}
+
+// findDirectorySyncManifest returns the manifest object under the key prefix, or an empty manifest if there is none.
+func findDirectorySyncManifest(ctx context.Context, conn *s3.S3, bucket, keyPrefix string) (*directorySyncManifest, error) {
+	key := keyPrefix + directorySyncManifestName
+	output, err := conn.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchKey) {
+		return &directorySyncManifest{}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading S3 object (%s): %w", key, err)
+	}
+
+	defer output.Body.Close()
+
+	manifest := &directorySyncManifest{}
+
+	if err := json.NewDecoder(output.Body).Decode(manifest); err != nil {
+		return nil, fmt.Errorf("decoding S3 object (%s): %w", key, err)
+	}
+
+	return manifest, nil
+}
+
+func putDirectorySyncManifest(ctx context.Context, conn *s3.S3, bucket, keyPrefix string, manifest *directorySyncManifest) error {
+	key := keyPrefix + directorySyncManifestName
+	body, err := json.Marshal(manifest)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Body:        bytes.NewReader(body),
+		Bucket:      aws.String(bucket),
+		ContentType: aws.String("application/json"),
+		Key:         aws.String(key),
+	})
+
+	if err != nil {
+		return fmt.Errorf("uploading S3 object (%s): %w", key, err)
+	}
+
+	return nil
+}
+
+// deleteDirectorySyncObjects deletes the current version of each object in batches of 1000.
+func deleteDirectorySyncObjects(ctx context.Context, conn *s3.S3, bucket string, keys []string) error {
+	const batchSize = 1000
+
+	for i := 0; i < len(keys); i += batchSize {
+		j := i + batchSize
+		if j > len(keys) {
+			j = len(keys)
+		}
+
+		toDelete := make([]*s3.ObjectIdentifier, 0, j-i)
+		for _, key := range keys[i:j] {
+			toDelete = append(toDelete, &s3.ObjectIdentifier{
+				Key: aws.String(key),
+			})
+		}
+
+		output, err := conn.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: toDelete,
+				Quiet:   aws.Bool(true), // Only report errors.
+			},
+		})
+
+		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting S3 Bucket (%s) objects: %w", bucket, err)
+		}
+
+		var deleteErrs *multierror.Error
+
+		for _, v := range output.Errors {
+			if aws.StringValue(v.Code) == s3.ErrCodeNoSuchKey {
+				continue
+			}
+
+			deleteErrs = multierror.Append(deleteErrs, newDeleteObjectVersionError(v))
+		}
+
+		if err := deleteErrs.ErrorOrNil(); err != nil {
+			return fmt.Errorf("deleting S3 Bucket (%s) objects: %w", bucket, err)
+		}
+	}
+
+	return nil
+}
+
+// findDirectorySyncObjects returns the ETag of every object under the key prefix that matches the filter.
+func findDirectorySyncObjects(ctx context.Context, conn *s3.S3, bucket, keyPrefix string, filter *directorySyncFilter) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if keyPrefix != "" {
+		input.Prefix = aws.String(keyPrefix)
+	}
+	objects := make(map[string]string)
+
+	err := conn.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Contents {
+			key := aws.StringValue(v.Key)
+			rel, ok := directorySyncRelativeKey(key, keyPrefix)
+
+			if !ok || !filter.match(rel) {
+				continue
+			}
+
+			objects[key] = strings.Trim(aws.StringValue(v.ETag), `"`)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
+
+// directorySyncRelativeKey returns the path of the object relative to the key prefix,
+// and whether the object is one this resource can manage.
+func directorySyncRelativeKey(key, keyPrefix string) (string, bool) {
+	if !strings.HasPrefix(key, keyPrefix) {
+		return "", false
+	}
+
+	rel := strings.TrimPrefix(key, keyPrefix)
+
+	// Skip "folder" placeholder objects and the manifest.
+	if rel == "" || strings.HasSuffix(rel, "/") || rel == directorySyncManifestName {
+		return "", false
+	}
+
+	return rel, true
+}
+
+// validDirectorySyncKeyPrefix requires the key prefix to end in "/", so that it only matches
+// the objects below it and not sibling keys that share its leading characters.
+func validDirectorySyncKeyPrefix(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value != "" && !strings.HasSuffix(value, "/") {
+		errors = append(errors, fmt.Errorf("%q must end with a slash (/), got: %s", k, value))
+	}
+
+	return
+}
+
+// walkDirectorySyncSource returns every regular file under the source directory that matches the filter.
+// Object keys are the key prefix followed by the slash-separated relative path.
+func walkDirectorySyncSource(sourceDir, keyPrefix string, filter *directorySyncFilter) ([]*directorySyncFile, error) {
+	root, err := homedir.Expand(sourceDir)
+
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source_dir (%s): %w", sourceDir, err)
+	}
+
+	var files []*directorySyncFile
+
+	err = filepath.WalkDir(root, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		if !filter.match(rel) || rel == directorySyncManifestName {
+			return nil
+		}
+
+		hash, contentType, err := inspectDirectorySyncFile(p)
+
+		if err != nil {
+			return err
+		}
+
+		files = append(files, &directorySyncFile{
+			contentType: contentType,
+			hash:        hash,
+			key:         keyPrefix + rel,
+			path:        p,
+			rel:         rel,
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source_dir (%s): %w", root, err)
+	}
+
+	return files, nil
+}
+
+// inspectDirectorySyncFile returns the ETag S3 will report for the file once uploaded
+// with the default s3manager settings and the file's detected content type.
+func inspectDirectorySyncFile(p string) (string, string, error) {
+	file, err := os.Open(p)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	defer file.Close()
+
+	info, err := file.Stat()
+
+	if err != nil {
+		return "", "", err
+	}
+
+	contentType := mime.TypeByExtension(filepath.Ext(p))
+
+	if contentType == "" {
+		head := make([]byte, 512)
+		n, err := io.ReadFull(file, head)
+
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return "", "", err
+		}
+
+		contentType = http.DetectContentType(head[:n])
+
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return "", "", err
+		}
+	}
+
+	etag, err := directorySyncETag(file, info.Size())
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return etag, contentType, nil
+}
+
+// directorySyncETag mirrors the part sizing in s3manager: objects no larger than a
+// single part are uploaded with PutObject and have an MD5 ETag, larger objects are
+// uploaded in parts and have an ETag of the MD5 of the part MD5s plus the part count.
+func directorySyncETag(r io.Reader, size int64) (string, error) {
+	partSize := int64(s3manager.DefaultUploadPartSize)
+
+	if size/partSize >= int64(s3manager.MaxUploadParts) {
+		partSize = (size / int64(s3manager.MaxUploadParts)) + 1
+	}
+
+	if size <= partSize {
+		h := md5.New()
+
+		if _, err := io.Copy(h, r); err != nil {
+			return "", err
+		}
+
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	var sums []byte
+	var parts int
+
+	for {
+		h := md5.New()
+		n, err := io.CopyN(h, r, partSize)
+
+		if n > 0 {
+			sums = append(sums, h.Sum(nil)...)
+			parts++
+		}
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return "", err
+		}
+	}
+
+	sum := md5.Sum(sums)
+
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(sum[:]), parts), nil
+}
+
+// directorySyncManifestHash returns a digest of the object keys and their hashes.
+func directorySyncManifestHash(objects map[string]string) string {
+	keys := make([]string, 0, len(objects))
+	for key := range objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	for _, key := range keys {
+		fmt.Fprintf(h, "%s %s\n", key, objects[key])
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func resolveDirectorySyncContentType(contentTypes map[string]string, ext, detected string) string {
+	if v, ok := contentTypes[ext]; ok {
+		return v
+	}
+
+	return detected
+}
+
+func expandDirectorySyncContentTypes(tfMap map[string]interface{}) map[string]string {
+	contentTypes := make(map[string]string, len(tfMap))
+
+	for k, v := range flex.ExpandStringValueMap(tfMap) {
+		ext := strings.ToLower(k)
+		if !strings.HasPrefix(ext, ".") {
+			ext = "." + ext
+		}
+		contentTypes[ext] = v
+	}
+
+	return contentTypes
+}
+
+type directorySyncCacheControlRule struct {
+	pattern *regexp.Regexp
+	value   string
+}
+
+type directorySyncCacheControlRules []directorySyncCacheControlRule
+
+// match returns the value of the first rule whose pattern matches the relative path.
+func (rules directorySyncCacheControlRules) match(rel string) string {
+	for _, rule := range rules {
+		if rule.pattern.MatchString(rel) {
+			return rule.value
+		}
+	}
+
+	return ""
+}
+
+func expandDirectorySyncCacheControlRules(tfList []interface{}) (directorySyncCacheControlRules, error) {
+	var rules directorySyncCacheControlRules
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		pattern, err := compileDirectorySyncGlob(tfMap["pattern"].(string))
+
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, directorySyncCacheControlRule{
+			pattern: pattern,
+			value:   tfMap["value"].(string),
+		})
+	}
+
+	return rules, nil
+}
+
+type directorySyncFilter struct {
+	exclude []*regexp.Regexp
+	include []*regexp.Regexp
+}
+
+// match reports whether the relative path is selected by the include globs (all paths if none)
+// and not rejected by any exclude glob.
+func (f *directorySyncFilter) match(rel string) bool {
+	for _, re := range f.exclude {
+		if re.MatchString(rel) {
+			return false
+		}
+	}
+
+	if len(f.include) == 0 {
+		return true
+	}
+
+	for _, re := range f.include {
+		if re.MatchString(rel) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func expandDirectorySyncFilter(include, exclude *schema.Set) (*directorySyncFilter, error) {
+	filter := &directorySyncFilter{}
+
+	for _, v := range flex.ExpandStringValueSet(include) {
+		re, err := compileDirectorySyncGlob(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		filter.include = append(filter.include, re)
+	}
+
+	for _, v := range flex.ExpandStringValueSet(exclude) {
+		re, err := compileDirectorySyncGlob(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		filter.exclude = append(filter.exclude, re)
+	}
+
+	return filter, nil
+}
+
+// compileDirectorySyncGlob converts a glob into a regular expression.
+// "*" and "?" do not match "/", "**" matches any number of path segments.
+// A pattern without a "/" is matched against the file name only.
+func compileDirectorySyncGlob(pattern string) (*regexp.Regexp, error) {
+	var sb strings.Builder
+
+	if !strings.Contains(pattern, "/") {
+		sb.WriteString(`^(?:.*/)?`)
+	} else {
+		sb.WriteString(`^`)
+	}
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					i++
+					sb.WriteString(`(?:.*/)?`)
+				} else {
+					sb.WriteString(`.*`)
+				}
+			} else {
+				sb.WriteString(`[^/]*`)
+			}
+		case '?':
+			sb.WriteString(`[^/]`)
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	sb.WriteString(`$`)
+
+	re, err := regexp.Compile(sb.String())
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid glob (%s): %w", pattern, err)
+	}
+
+	return re, nil
+}
@@ -0,0 +1,424 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestDirectorySyncRelativeKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		key       string
+		keyPrefix string
+		rel       string
+		ok        bool
+	}{
+		{key: "site/index.html", keyPrefix: "site/", rel: "index.html", ok: true},
+		{key: "site/css/site.css", keyPrefix: "site/", rel: "css/site.css", ok: true},
+		{key: "site/", keyPrefix: "site/", ok: false},
+		{key: "site/css/", keyPrefix: "site/", ok: false},
+		{key: "site/.terraform-directory-sync.json", keyPrefix: "site/", ok: false},
+		// Sibling keys that share the leading characters of the prefix are left alone.
+		{key: "site-old/index.html", keyPrefix: "site/", ok: false},
+		{key: "sitemap.xml", keyPrefix: "site/", ok: false},
+		{key: "index.html", keyPrefix: "", rel: "index.html", ok: true},
+	}
+
+	for _, testCase := range testCases {
+		rel, ok := tfs3.DirectorySyncRelativeKey(testCase.key, testCase.keyPrefix)
+
+		if ok != testCase.ok || rel != testCase.rel {
+			t.Errorf("DirectorySyncRelativeKey(%q, %q) = %q, %t, expected %q, %t", testCase.key, testCase.keyPrefix, rel, ok, testCase.rel, testCase.ok)
+		}
+	}
+}
+
+func TestValidDirectorySyncKeyPrefix(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"", "site/", "a/b/"} {
+		if _, errors := tfs3.ValidDirectorySyncKeyPrefix(v, "key_prefix"); len(errors) != 0 {
+			t.Errorf("%q should be a valid key prefix: %q", v, errors)
+		}
+	}
+
+	for _, v := range []string{"site", "a/b", "site-"} {
+		if _, errors := tfs3.ValidDirectorySyncKeyPrefix(v, "key_prefix"); len(errors) == 0 {
+			t.Errorf("%q should be an invalid key prefix", v)
+		}
+	}
+}
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccDirectorySyncCreateTempDir(t, map[string]string{
+		"index.html":        "<html></html>",
+		"css/site.css":      "body {}",
+		"scratch/notes.tmp": "ignored",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "changes.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "changes.site/index.html", "create"),
+					resource.TestCheckResourceAttr(resourceName, "changes.site/css/site.css", "create"),
+					resource.TestCheckResourceAttr(resourceName, "file_count", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest_hash"),
+					testAccCheckDirectorySyncObject(ctx, resourceName, "site/index.html", "text/html; charset=utf-8", "no-cache"),
+					testAccCheckDirectorySyncObject(ctx, resourceName, "site/css/site.css", "text/css; charset=utf-8", "max-age=3600"),
+					testAccCheckDirectorySyncObjectNotExists(ctx, resourceName, "site/scratch/notes.tmp"),
+				),
+			},
+			{
+				Config:   testAccDirectorySyncConfig_basic(rName, source),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					testAccDirectorySyncWriteFiles(t, source, map[string]string{
+						"index.html": "<html><body></body></html>",
+					})
+				},
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						testAccCheckDirectorySyncPlannedChanges(resourceName, map[string]string{
+							"site/index.html": "update",
+						}),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "changes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "changes.site/index.html", "update"),
+					resource.TestCheckResourceAttr(resourceName, "file_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_deleteOrphans(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccDirectorySyncCreateTempDir(t, map[string]string{
+		"index.html": "<html></html>",
+		"old.txt":    "old",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_deleteOrphans(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "file_count", "2"),
+					testAccCheckDirectorySyncObject(ctx, resourceName, "old.txt", "text/plain; charset=utf-8", ""),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectorySyncWriteFiles(t, source, map[string]string{
+						"index.html": "<html><body></body></html>",
+					})
+					if err := os.Remove(filepath.Join(source, "old.txt")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_deleteOrphans(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "changes.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "changes.index.html", "update"),
+					resource.TestCheckResourceAttr(resourceName, "changes.old.txt", "delete"),
+					resource.TestCheckResourceAttr(resourceName, "file_count", "1"),
+					testAccCheckDirectorySyncObjectNotExists(ctx, resourceName, "old.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_contentTypes(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccDirectorySyncCreateTempDir(t, map[string]string{
+		"data.geojson": "{}",
+		"README":       "plain text",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_contentTypes(rName, source, "application/json"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObject(ctx, resourceName, "data.geojson", "application/json", ""),
+					testAccCheckDirectorySyncObject(ctx, resourceName, "README", "text/plain; charset=utf-8", ""),
+				),
+			},
+			{
+				Config: testAccDirectorySyncConfig_contentTypes(rName, source, "application/geo+json"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "changes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "changes.data.geojson", "update"),
+					testAccCheckDirectorySyncObject(ctx, resourceName, "data.geojson", "application/geo+json", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccDirectorySyncCreateTempDir(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	testAccDirectorySyncWriteFiles(t, dir, files)
+
+	return dir
+}
+
+func testAccDirectorySyncWriteFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccCheckDirectorySyncDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_directory_sync" {
+				continue
+			}
+
+			output, err := conn.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
+				Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+				Prefix: aws.String(rs.Primary.Attributes["key_prefix"]),
+			})
+
+			if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output.Contents) > 0 {
+				return fmt.Errorf("S3 Directory Sync %s still has %d objects", rs.Primary.ID, len(output.Contents))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObject(ctx context.Context, n, key, contentType, cacheControl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn(ctx)
+
+		output, err := tfs3.FindObjectByThreePartKey(ctx, conn, rs.Primary.Attributes["bucket"], key, "")
+
+		if err != nil {
+			return fmt.Errorf("S3 object (%s): %w", key, err)
+		}
+
+		if got := aws.StringValue(output.ContentType); got != contentType {
+			return fmt.Errorf("S3 object (%s) Content-Type: expected %q, got %q", key, contentType, got)
+		}
+
+		if got := aws.StringValue(output.CacheControl); got != cacheControl {
+			return fmt.Errorf("S3 object (%s) Cache-Control: expected %q, got %q", key, cacheControl, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjectNotExists(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn(ctx)
+
+		_, err := tfs3.FindObjectByThreePartKey(ctx, conn, rs.Primary.Attributes["bucket"], key, "")
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 object (%s) still exists", key)
+	}
+}
+
+type directorySyncPlannedChangesCheck struct {
+	resourceAddress string
+	changes         map[string]string
+}
+
+func (c directorySyncPlannedChangesCheck) CheckPlan(ctx context.Context, req plancheck.CheckPlanRequest, resp *plancheck.CheckPlanResponse) {
+	for _, rc := range req.Plan.ResourceChanges {
+		if rc.Address != c.resourceAddress {
+			continue
+		}
+
+		after, ok := rc.Change.After.(map[string]interface{})
+		if !ok {
+			resp.Error = fmt.Errorf("%s: no planned values", c.resourceAddress)
+			return
+		}
+
+		// Unknown values are absent from the planned values.
+		changes, ok := after["changes"].(map[string]interface{})
+		if !ok {
+			resp.Error = fmt.Errorf("%s: changes is not known at plan time", c.resourceAddress)
+			return
+		}
+
+		if len(changes) != len(c.changes) {
+			resp.Error = fmt.Errorf("%s: expected %d planned changes, got %d: %v", c.resourceAddress, len(c.changes), len(changes), changes)
+			return
+		}
+
+		for key, expected := range c.changes {
+			if got, _ := changes[key].(string); got != expected {
+				resp.Error = fmt.Errorf("%s: planned change for %s: expected %q, got %q", c.resourceAddress, key, expected, got)
+				return
+			}
+		}
+
+		return
+	}
+
+	resp.Error = fmt.Errorf("%s: not found in plan", c.resourceAddress)
+}
+
+// testAccCheckDirectorySyncPlannedChanges checks the planned value of the changes attribute.
+func testAccCheckDirectorySyncPlannedChanges(resourceAddress string, changes map[string]string) plancheck.PlanCheck {
+	return directorySyncPlannedChangesCheck{
+		resourceAddress: resourceAddress,
+		changes:         changes,
+	}
+}
+
+func testAccDirectorySyncConfig_basic(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source_dir = %[2]q
+
+  exclude = ["*.tmp"]
+
+  cache_control {
+    pattern = "*.html"
+    value   = "no-cache"
+  }
+
+  cache_control {
+    pattern = "**"
+    value   = "max-age=3600"
+  }
+}
+`, rName, source)
+}
+
+func testAccDirectorySyncConfig_deleteOrphans(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  source_dir     = %[2]q
+  delete_orphans = true
+
+  content_types = {
+    ".txt" = "text/plain; charset=utf-8"
+  }
+}
+`, rName, source)
+}
+
+func testAccDirectorySyncConfig_contentTypes(rName, source, contentType string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source_dir = %[2]q
+
+  content_types = {
+    ".geojson" = %[3]q
+  }
+}
+`, rName, source, contentType)
+}
@@ -0,0 +1,11 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+// Exports for use in tests only.
+var (
+	DirectorySyncRelativeKey           = directorySyncRelativeKey
+	ValidDirectorySyncKeyPrefix        = validDirectorySyncKeyPrefix
+	ValidateObjectSinglePartUploadSize = validateObjectSinglePartUploadSize
+)
@@ -6,8 +6,12 @@ package s3
 import (
 	"bytes"
 	"context"
+	"crypto/sha1"
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"hash"
+	"hash/crc32"
 	"io"
 	"log"
 	"net/http"
@@ -36,7 +40,12 @@ import (
 	"github.com/mitchellh/go-homedir"
 )
 
-const objectCreationTimeout = 2 * time.Minute
+const (
+	objectCreationTimeout = 2 * time.Minute
+
+	// objectSinglePartUploadMaxSize is the maximum size of an object uploaded with a single PutObject request.
+	objectSinglePartUploadMaxSize = 5 * 1024 * 1024 * 1024
+)
 
 // @SDKResource("aws_s3_object", name="Object")
 // @Tags
@@ -77,6 +86,27 @@ func ResourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"checksum_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ChecksumAlgorithm_Values(), false),
+			},
+			"checksum_crc32": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_crc32c": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha1": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"content": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -251,6 +281,10 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 	// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
 	d.Set("etag", strings.Trim(aws.StringValue(output.ETag), `"`))
 
+	if err := resourceObjectSetChecksum(ctx, d, conn, bucket, key); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Object (%s) checksum: %s", d.Id(), err)
+	}
+
 	// The "STANDARD" (which is also the default) storage
 	// class when set would not be included in the results.
 	if output.StorageClass == nil {
@@ -281,7 +315,7 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	if hasObjectContentChanges(d) {
+	if hasObjectContentChanges(d) || d.HasChanges(objectChecksumAttributes()...) {
 		return append(diags, resourceObjectUpload(ctx, d, meta)...)
 	}
 
@@ -513,6 +547,29 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ObjectLockRetainUntilDate = expandObjectDate(v.(string))
 	}
 
+	if v, ok := d.GetOk("checksum_algorithm"); ok {
+		algorithm := v.(string)
+		checksum, err := objectChecksum(body, algorithm)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "computing %s checksum: %s", algorithm, err)
+		}
+
+		input.ChecksumAlgorithm = aws.String(algorithm)
+		setUploadInputChecksum(input, algorithm, checksum)
+
+		// Precomputed checksums are ignored for multipart uploads, so upload the object in a single part.
+		size, err := aws.SeekerLen(body)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 object size: %s", err)
+		}
+		if size > objectSinglePartUploadMaxSize {
+			return sdkdiag.AppendErrorf(diags, "uploading object to S3 bucket (%s): objects with checksum_algorithm set cannot be larger than 5 GiB, got %d bytes", bucket, size)
+		}
+		if size > uploader.PartSize {
+			uploader.PartSize = size
+		}
+	}
+
 	if _, err := uploader.Upload(input); err != nil {
 		return sdkdiag.AppendErrorf(diags, "uploading object to S3 bucket (%s): %s", bucket, err)
 	}
@@ -554,6 +611,10 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 }
 
 func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := resourceObjectChecksumCustomizeDiff(d); err != nil {
+		return err
+	}
+
 	if hasObjectContentChanges(d) {
 		return d.SetNewComputed("version_id")
 	}
@@ -570,6 +631,7 @@ func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
 		"cache_control",
+		"checksum_algorithm",
 		"content_base64",
 		"content_disposition",
 		"content_encoding",
@@ -592,6 +654,184 @@ func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 	return false
 }
 
+// resourceObjectChecksumCustomizeDiff plans the additional checksum of the object.
+// The checksum of a local source file is computed so that changes to the file's content
+// are detected without relying on the ETag, which is not an MD5 digest for multipart
+// uploads or objects encrypted with SSE-KMS.
+func resourceObjectChecksumCustomizeDiff(d *schema.ResourceDiff) error {
+	algorithm := d.Get("checksum_algorithm").(string)
+	var checksum string
+
+	if v, ok := d.GetOk("source"); ok && algorithm != "" && d.NewValueKnown("source") {
+		if path, err := homedir.Expand(v.(string)); err == nil {
+			if err := validateObjectSinglePartUploadSize(path); err != nil {
+				return err
+			}
+
+			if file, err := os.Open(path); err == nil {
+				checksum, err = objectChecksum(file, algorithm)
+				file.Close()
+				if err != nil {
+					return fmt.Errorf("computing %s checksum of S3 object source (%s): %w", algorithm, path, err)
+				}
+			}
+		}
+	}
+
+	for _, v := range s3.ChecksumAlgorithm_Values() {
+		key := objectChecksumAttribute(v)
+
+		switch {
+		case v != algorithm:
+			if d.Get(key).(string) != "" {
+				if err := d.SetNew(key, ""); err != nil {
+					return err
+				}
+			}
+		case checksum != "":
+			// An object uploaded in parts outside of Terraform has a composite checksum (<base64>-<parts>),
+			// which never matches the full-object checksum of the file. Such an object is re-uploaded in a single part.
+			if d.Get(key).(string) != checksum {
+				if err := d.SetNew(key, checksum); err != nil {
+					return err
+				}
+				if err := d.SetNewComputed("etag"); err != nil {
+					return err
+				}
+				if err := d.SetNewComputed("version_id"); err != nil {
+					return err
+				}
+			}
+		case hasObjectContentChanges(d) || d.HasChange("source_hash"):
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateObjectSinglePartUploadSize returns an error if the local file is too large to be
+// uploaded in a single part, which objects with an additional checksum require.
+func validateObjectSinglePartUploadSize(path string) error {
+	fi, err := os.Stat(path)
+
+	if err != nil {
+		// The file may be created during the apply.
+		return nil
+	}
+
+	if size := fi.Size(); size > objectSinglePartUploadMaxSize {
+		return fmt.Errorf("S3 object source (%s) is %d bytes: objects with checksum_algorithm set are uploaded in a single part and cannot be larger than 5 GiB", path, size)
+	}
+
+	return nil
+}
+
+func resourceObjectSetChecksum(ctx context.Context, d *schema.ResourceData, conn *s3.S3, bucket, key string) error {
+	var checksum *s3.Checksum
+
+	// GetObjectAttributes requires additional permissions, so only call it when a checksum is configured.
+	if _, ok := d.GetOk("checksum_algorithm"); ok {
+		var err error
+		checksum, err = findObjectChecksum(ctx, conn, bucket, key)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if checksum == nil {
+		checksum = &s3.Checksum{}
+	}
+
+	d.Set("checksum_crc32", checksum.ChecksumCRC32)
+	d.Set("checksum_crc32c", checksum.ChecksumCRC32C)
+	d.Set("checksum_sha1", checksum.ChecksumSHA1)
+	d.Set("checksum_sha256", checksum.ChecksumSHA256)
+
+	return nil
+}
+
+func findObjectChecksum(ctx context.Context, conn *s3.S3, bucket, key string) (*s3.Checksum, error) {
+	input := &s3.GetObjectAttributesInput{
+		Bucket:           aws.String(bucket),
+		Key:              aws.String(key),
+		ObjectAttributes: aws.StringSlice([]string{s3.ObjectAttributesChecksum}),
+	}
+
+	output, err := conn.GetObjectAttributesWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Checksum, nil
+}
+
+func objectChecksumAttribute(algorithm string) string {
+	return "checksum_" + strings.ToLower(algorithm)
+}
+
+func objectChecksumAttributes() []string {
+	var keys []string
+
+	for _, v := range s3.ChecksumAlgorithm_Values() {
+		keys = append(keys, objectChecksumAttribute(v))
+	}
+
+	return keys
+}
+
+// objectChecksum returns the base64-encoded checksum of the content, as S3 reports it,
+// and rewinds the content if it is seekable.
+func objectChecksum(r io.Reader, algorithm string) (string, error) {
+	var h hash.Hash
+
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		h = crc32.NewIEEE()
+	case s3.ChecksumAlgorithmCrc32c:
+		h = crc32.New(crc32.MakeTable(crc32.Castagnoli))
+	case s3.ChecksumAlgorithmSha1:
+		h = sha1.New()
+	case s3.ChecksumAlgorithmSha256:
+		h = sha256.New()
+	default:
+		return "", fmt.Errorf("unsupported checksum algorithm: %s", algorithm)
+	}
+
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+
+	if s, ok := r.(io.Seeker); ok {
+		if _, err := s.Seek(0, io.SeekStart); err != nil {
+			return "", err
+		}
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+func setUploadInputChecksum(input *s3manager.UploadInput, algorithm, checksum string) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		input.ChecksumCRC32 = aws.String(checksum)
+	case s3.ChecksumAlgorithmCrc32c:
+		input.ChecksumCRC32C = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha1:
+		input.ChecksumSHA1 = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha256:
+		input.ChecksumSHA256 = aws.String(checksum)
+	}
+}
+
 // DeleteAllObjectVersions deletes all versions of a specified key from an S3 bucket.
 // If key is empty then all versions of all objects are deleted.
 // Set force to true to override any S3 object lock protections on object lock enabled buckets.
@@ -207,6 +207,93 @@ func TestAccS3Object_contentBase64(t *testing.T) {
 	})
 }
 
+func TestValidateObjectSinglePartUploadSize(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	for _, testCase := range []struct {
+		size  int64
+		valid bool
+	}{
+		{size: 0, valid: true},
+		{size: 5 * 1024 * 1024 * 1024, valid: true},
+		{size: 5*1024*1024*1024 + 1, valid: false},
+	} {
+		path := fmt.Sprintf("%s/%d", dir, testCase.size)
+		file, err := os.Create(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Sparse file, no disk space is used.
+		if err := file.Truncate(testCase.size); err != nil {
+			t.Fatal(err)
+		}
+		file.Close()
+
+		err = tfs3.ValidateObjectSinglePartUploadSize(path)
+
+		if got := err == nil; got != testCase.valid {
+			t.Errorf("ValidateObjectSinglePartUploadSize(%d bytes) = %v, expected valid: %t", testCase.size, err, testCase.valid)
+		}
+	}
+
+	if err := tfs3.ValidateObjectSinglePartUploadSize(dir + "/missing"); err != nil {
+		t.Errorf("ValidateObjectSinglePartUploadSize(missing file) = %v, expected no error", err)
+	}
+}
+
+func TestAccS3Object_checksumAlgorithm(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj, updated_obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	filename := testAccObjectCreateTempFile(t, "initial object state")
+	defer os.Remove(filename)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_checksumAlgorithm(rName, filename, "SHA256"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "initial object state"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "SHA256"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "qA45iKZtj4onhMdplMQOEOMZwIAbtPVj8142IElDrNg="),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(filename, []byte("modified object"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObjectConfig_checksumAlgorithm(rName, filename, "SHA256"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &updated_obj),
+					testAccCheckObjectBody(&updated_obj, "modified object"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "bXX16eYJAc6UKKNNBq9nfpck99FS7r90p3ZKq620IgU="),
+				),
+			},
+			{
+				Config: testAccObjectConfig_checksumAlgorithm(rName, filename, "CRC32"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &updated_obj),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "CRC32"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", "yQ/DPg=="),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3Object_sourceHashTrigger(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj, updated_obj s3.GetObjectOutput
@@ -1690,6 +1777,21 @@ resource "aws_s3_object" "object" {
 `, rName, contentBase64)
 }
 
+func testAccObjectConfig_checksumAlgorithm(rName, source, checksumAlgorithm string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = "test-key"
+  source             = %[2]q
+  checksum_algorithm = %[3]q
+}
+`, rName, source, checksumAlgorithm)
+}
+
 func testAccObjectConfig_sourceHashTrigger(rName string, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {